@@ -21,6 +21,7 @@ import (
 	"os/exec"
 	"strings"
 
+	"istio.io/istio/tools/istio-iptables/pkg/constants"
 	"istio.io/pkg/log"
 )
 
@@ -28,6 +29,9 @@ var (
 	nsSetupProg = "istio-iptables"
 )
 
+// ownerComponent tags the rules programmed by the plugin, see istio-iptables --owner-component
+const ownerComponent = "istio-cni"
+
 type iptables struct {
 }
 
@@ -35,9 +39,9 @@ func newIPTables() InterceptRuleMgr {
 	return &iptables{}
 }
 
-// Program defines a method which programs iptables based on the parameters
-// provided in Redirect.
-func (ipt *iptables) Program(netns string, rdrct *Redirect) error {
+// buildNsenterArgs returns the nsenter arguments running istio-iptables in netns with the
+// parameters provided in Redirect.
+func buildNsenterArgs(netns string, rdrct *Redirect) []string {
 	netnsArg := fmt.Sprintf("--net=%s", netns)
 	nsSetupExecutable := fmt.Sprintf("%s/%s", nsSetupBinDir, nsSetupProg)
 	nsenterArgs := []string{
@@ -52,7 +56,27 @@ func (ipt *iptables) Program(netns string, rdrct *Redirect) error {
 		"-o", rdrct.excludeOutboundPorts,
 		"-x", rdrct.excludeIPCidrs,
 		"-k", rdrct.kubevirtInterfaces,
+		"--" + constants.OwnerComponent, ownerComponent,
+	}
+	if rdrct.revision != "" {
+		nsenterArgs = append(nsenterArgs, "--"+constants.OwnerRevision, rdrct.revision)
+	}
+	if rdrct.iptablesVariant != "" {
+		nsenterArgs = append(nsenterArgs, "--"+constants.IptablesVariant, rdrct.iptablesVariant)
+	}
+	if rdrct.dropInvalid {
+		nsenterArgs = append(nsenterArgs, "--"+constants.DropInvalid)
 	}
+	if rdrct.dropMartianSources {
+		nsenterArgs = append(nsenterArgs, "--"+constants.DropMartianSources)
+	}
+	return nsenterArgs
+}
+
+// Program defines a method which programs iptables based on the parameters
+// provided in Redirect.
+func (ipt *iptables) Program(netns string, rdrct *Redirect) error {
+	nsenterArgs := buildNsenterArgs(netns, rdrct)
 	log.Infof("nsenter args: %s", strings.Join(nsenterArgs, " "))
 	out, err := exec.Command("nsenter", nsenterArgs...).CombinedOutput()
 	if err != nil {
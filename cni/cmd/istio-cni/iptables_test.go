@@ -0,0 +1,88 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testRedirect(t *testing.T) *Redirect {
+	redirect, err := NewRedirect(map[string]string{})
+	if err != nil {
+		t.Fatalf("NewRedirect failed: %v", err)
+	}
+	return redirect
+}
+
+func TestBuildNsenterArgs(t *testing.T) {
+	actual := buildNsenterArgs("/var/run/netns/test", testRedirect(t))
+	expected := []string{
+		"--net=/var/run/netns/test",
+		nsSetupBinDir + "/istio-iptables",
+		"-p", "15001",
+		"-u", "1337",
+		"-m", "REDIRECT",
+		"-i", "*",
+		"-b", "*",
+		"-d", "15020,15021,15090",
+		"-o", "15020",
+		"-x", "",
+		"-k", "",
+		"--owner-component", "istio-cni",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Output mismatch\nExpected: %#v\nActual: %#v", expected, actual)
+	}
+}
+
+func TestBuildNsenterArgsWithRevision(t *testing.T) {
+	redirect := testRedirect(t)
+	redirect.revision = "canary"
+
+	actual := buildNsenterArgs("/var/run/netns/test", redirect)
+	expected := []string{"--owner-component", "istio-cni", "--owner-revision", "canary"}
+	if tail := actual[len(actual)-len(expected):]; !reflect.DeepEqual(tail, expected) {
+		t.Errorf("Expected the arguments to end with %#v; got %#v", expected, actual)
+	}
+}
+
+func TestBuildNsenterArgsWithHardening(t *testing.T) {
+	redirect := testRedirect(t)
+	redirect.dropInvalid = true
+	redirect.dropMartianSources = true
+
+	actual := buildNsenterArgs("/var/run/netns/test", redirect)
+	expected := []string{"--drop-invalid", "--drop-martian-sources"}
+	if tail := actual[len(actual)-len(expected):]; !reflect.DeepEqual(tail, expected) {
+		t.Errorf("Expected the arguments to end with %#v; got %#v", expected, actual)
+	}
+}
+
+func TestBuildNsenterArgsWithIptablesVariant(t *testing.T) {
+	redirect := testRedirect(t)
+	for _, arg := range buildNsenterArgs("/var/run/netns/test", redirect) {
+		if arg == "--iptables-variant" {
+			t.Fatalf("Expected no iptables variant to be passed when unset")
+		}
+	}
+
+	redirect.iptablesVariant = "nft"
+	actual := buildNsenterArgs("/var/run/netns/test", redirect)
+	expected := []string{"--iptables-variant", "nft"}
+	if tail := actual[len(actual)-len(expected):]; !reflect.DeepEqual(tail, expected) {
+		t.Errorf("Expected the arguments to end with %#v; got %#v", expected, actual)
+	}
+}
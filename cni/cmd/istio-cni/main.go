@@ -30,6 +30,8 @@ import (
 	"github.com/containernetworking/cni/pkg/version"
 
 	"istio.io/api/annotation"
+	"istio.io/api/label"
+	dep "istio.io/istio/tools/istio-iptables/pkg/dependencies"
 	"istio.io/pkg/log"
 )
 
@@ -75,8 +77,11 @@ type PluginConf struct {
 	PrevResult    *current.Result         `json:"-"`
 
 	// Add plugin-specific flags here
-	LogLevel   string     `json:"log_level"`
-	Kubernetes Kubernetes `json:"kubernetes"`
+	LogLevel           string     `json:"log_level"`
+	IptablesVariant    string     `json:"iptables_variant"`
+	DropInvalid        bool       `json:"drop_invalid"`
+	DropMartianSources bool       `json:"drop_martian_sources"`
+	Kubernetes         Kubernetes `json:"kubernetes"`
 }
 
 // K8sArgs is the valid CNI_ARGS used for Kubernetes
@@ -115,6 +120,11 @@ func parseConfig(stdin []byte) (*PluginConf, error) {
 	}
 	// End previous result parsing
 
+	// Reject a bad variant once for the whole config, rather than failing every redirect
+	if err := dep.ValidateIptablesVariant(conf.IptablesVariant); err != nil {
+		return nil, err
+	}
+
 	return &conf, nil
 }
 
@@ -169,10 +179,11 @@ func cmdAdd(args *skel.CmdArgs) error {
 			log.Debugf("Created Kubernetes client: %v", client)
 			var containers []string
 			var initContainersMap map[string]struct{}
+			var labels map[string]string
 			var annotations map[string]string
 			var k8sErr error
 			for attempt := 1; attempt <= podRetrievalMaxRetries; attempt++ {
-				containers, initContainersMap, _, annotations, k8sErr = getKubePodInfo(client, string(k8sArgs.K8S_POD_NAME), string(k8sArgs.K8S_POD_NAMESPACE))
+				containers, initContainersMap, labels, annotations, k8sErr = getKubePodInfo(client, string(k8sArgs.K8S_POD_NAME), string(k8sArgs.K8S_POD_NAMESPACE))
 				if k8sErr == nil {
 					break
 				}
@@ -221,6 +232,12 @@ func cmdAdd(args *skel.CmdArgs) error {
 						log.Errorf("Pod redirect failed due to bad params: %v", redirErr)
 					} else {
 						log.Infof("Redirect local ports: %v", redirect.includePorts)
+						// Tag the rules with the revision of the control plane which injected the pod
+						redirect.revision = labels[label.IstioRev]
+						redirect.dropInvalid = conf.DropInvalid
+						redirect.dropMartianSources = conf.DropMartianSources
+						// istio-iptables only sees the pod netns, so the variant is detected from the host rules by install-cni
+						redirect.iptablesVariant = conf.IptablesVariant
 						// Get the constructor for the configured type of InterceptRuleMgr
 						interceptMgrCtor := GetInterceptRuleMgrCtor(interceptRuleMgrType)
 						if interceptMgrCtor == nil {
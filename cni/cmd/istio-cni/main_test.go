@@ -26,6 +26,8 @@ import (
 	"github.com/containernetworking/cni/pkg/types/current"
 	"github.com/containernetworking/plugins/pkg/testutils"
 	"k8s.io/client-go/kubernetes"
+
+	"istio.io/api/label"
 )
 
 var (
@@ -245,6 +247,76 @@ func TestCmdAddTwoContainers(t *testing.T) {
 	}
 }
 
+func TestCmdAddTwoContainersWithHardening(t *testing.T) {
+	defer resetGlobalTestVariables()
+	testContainers = []string{"mockContainer", "mockContainer2"}
+
+	cniConf := strings.Replace(fmt.Sprintf(conf, currentVersion, ifname, sandboxDirectory),
+		`"log_level": "debug",`, `"log_level": "debug", "drop_invalid": true, "drop_martian_sources": true,`, 1)
+	testCmdAddWithStdinData(t, cniConf)
+
+	if !nsenterFuncCalled {
+		t.Fatalf("expected nsenterFunc to be called")
+	}
+	mockIntercept, ok := GetInterceptRuleMgrCtor("mock")().(*mockInterceptRuleMgr)
+	if !ok {
+		t.Fatalf("expect using mockInterceptRuleMgr, actual %v", InterceptRuleMgrTypes["mock"]())
+	}
+	r := mockIntercept.lastRedirect[len(mockIntercept.lastRedirect)-1]
+	if !r.dropInvalid || !r.dropMartianSources {
+		t.Fatalf("expect both hardening options to be enabled, actual %v and %v", r.dropInvalid, r.dropMartianSources)
+	}
+}
+
+func TestCmdAddTwoContainersWithRevision(t *testing.T) {
+	defer resetGlobalTestVariables()
+	testContainers = []string{"mockContainer", "mockContainer2"}
+	testLabels[label.IstioRev] = "canary"
+
+	testCmdAdd(t)
+
+	if !nsenterFuncCalled {
+		t.Fatalf("expected nsenterFunc to be called")
+	}
+	mockIntercept, ok := GetInterceptRuleMgrCtor("mock")().(*mockInterceptRuleMgr)
+	if !ok {
+		t.Fatalf("expect using mockInterceptRuleMgr, actual %v", InterceptRuleMgrTypes["mock"]())
+	}
+	r := mockIntercept.lastRedirect[len(mockIntercept.lastRedirect)-1]
+	if r.revision != "canary" {
+		t.Fatalf("expect revision to be taken from the %s label, actual %v", label.IstioRev, r.revision)
+	}
+}
+
+func TestCmdAddTwoContainersWithIptablesVariant(t *testing.T) {
+	defer resetGlobalTestVariables()
+	testContainers = []string{"mockContainer", "mockContainer2"}
+
+	cniConf := strings.Replace(fmt.Sprintf(conf, currentVersion, ifname, sandboxDirectory),
+		`"log_level": "debug",`, `"log_level": "debug", "iptables_variant": "legacy",`, 1)
+	testCmdAddWithStdinData(t, cniConf)
+
+	if !nsenterFuncCalled {
+		t.Fatalf("expected nsenterFunc to be called")
+	}
+	mockIntercept, ok := GetInterceptRuleMgrCtor("mock")().(*mockInterceptRuleMgr)
+	if !ok {
+		t.Fatalf("expect using mockInterceptRuleMgr, actual %v", InterceptRuleMgrTypes["mock"]())
+	}
+	r := mockIntercept.lastRedirect[len(mockIntercept.lastRedirect)-1]
+	if r.iptablesVariant != "legacy" {
+		t.Fatalf("expect iptablesVariant to be taken from the config, actual %v", r.iptablesVariant)
+	}
+}
+
+func TestParseConfigInvalidIptablesVariant(t *testing.T) {
+	cniConf := strings.Replace(fmt.Sprintf(conf, currentVersion, ifname, sandboxDirectory),
+		`"log_level": "debug",`, `"log_level": "debug", "iptables_variant": "nftables",`, 1)
+	if _, err := parseConfig([]byte(cniConf)); err == nil || !strings.Contains(err.Error(), "invalid iptables variant") {
+		t.Fatalf("expected an invalid iptables variant error, got: %v", err)
+	}
+}
+
 func TestCmdAddTwoContainersWithStarInboundPort(t *testing.T) {
 	defer resetGlobalTestVariables()
 	testAnnotations[includePortsKey] = "*"
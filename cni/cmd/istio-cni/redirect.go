@@ -75,6 +75,10 @@ type Redirect struct {
 	excludeInboundPorts  string
 	excludeOutboundPorts string
 	kubevirtInterfaces   string
+	revision             string
+	iptablesVariant      string
+	dropInvalid          bool
+	dropMartianSources   bool
 }
 
 type annotationValidationFunc func(value string) error
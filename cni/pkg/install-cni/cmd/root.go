@@ -26,6 +26,7 @@ import (
 	"istio.io/istio/cni/pkg/install-cni/pkg/config"
 	"istio.io/istio/cni/pkg/install-cni/pkg/constants"
 	"istio.io/istio/cni/pkg/install-cni/pkg/install"
+	dep "istio.io/istio/tools/istio-iptables/pkg/dependencies"
 	"istio.io/pkg/log"
 )
 
@@ -77,6 +78,9 @@ func init() {
 	registerBooleanParameter(constants.ChainedCNIPlugin, true, "Whether to install CNI plugin as a chained or standalone")
 	registerStringParameter(constants.CNINetworkConfig, "", "CNI config template as a string")
 	registerStringParameter(constants.LogLevel, "warn", "Fallback value for log level in CNI config file, if not specified in helm template")
+	registerStringParameter(constants.IptablesVariant, "",
+		"The iptables variant used by the CNI plugin, either \"legacy\", \"nft\" or \"auto\" to detect it from the rules of the host. "+
+			"If unset, the default iptables binaries are used")
 
 	// Not configurable in CNI helm charts
 	registerStringParameter(constants.MountedCNINetDir, "/host/etc/cni/net.d", "Directory on the container where CNI networks are installed")
@@ -127,6 +131,7 @@ func constructConfig() (*config.Config, error) {
 		CNINetworkConfig:     viper.GetString(constants.CNINetworkConfig),
 
 		LogLevel:           viper.GetString(constants.LogLevel),
+		IptablesVariant:    viper.GetString(constants.IptablesVariant),
 		KubeconfigFilename: viper.GetString(constants.KubeconfigFilename),
 		KubeconfigMode:     viper.GetInt(constants.KubeconfigMode),
 		KubeCAFile:         viper.GetString(constants.KubeCAFile),
@@ -140,6 +145,10 @@ func constructConfig() (*config.Config, error) {
 		SkipCNIBinaries:   viper.GetStringSlice(constants.SkipCNIBinaries),
 	}
 
+	if err := dep.ValidateIptablesVariant(cfg.IptablesVariant); err != nil {
+		return nil, err
+	}
+
 	if len(cfg.K8sNodeName) == 0 {
 		var err error
 		cfg.K8sNodeName, err = os.Hostname()
@@ -25,6 +25,7 @@ type Config struct {
 	CNINetworkConfig     string
 
 	LogLevel           string
+	IptablesVariant    string
 	KubeconfigFilename string
 	KubeconfigMode     int
 	KubeCAFile         string
@@ -30,6 +30,8 @@ import (
 	"istio.io/istio/cni/pkg/install-cni/pkg/config"
 	"istio.io/istio/cni/pkg/install-cni/pkg/util"
 	"istio.io/istio/pkg/file"
+	iptablesconstants "istio.io/istio/tools/istio-iptables/pkg/constants"
+	dep "istio.io/istio/tools/istio-iptables/pkg/dependencies"
 	"istio.io/pkg/log"
 )
 
@@ -44,10 +46,14 @@ type cniConfigTemplate struct {
 	cniNetworkConfig     string
 }
 
+// detectIptablesVariant is replaced in tests, so that they do not depend on the rules of the host
+var detectIptablesVariant = dep.DetectIptablesVariant
+
 type cniConfigVars struct {
 	cniNetDir          string
 	kubeconfigFilename string
 	logLevel           string
+	iptablesVariant    string
 	k8sServiceHost     string
 	k8sServicePort     string
 	k8sNodeName        string
@@ -73,6 +79,7 @@ func getCNIConfigVars(cfg *config.Config) cniConfigVars {
 		cniNetDir:          cfg.CNINetDir,
 		kubeconfigFilename: cfg.KubeconfigFilename,
 		logLevel:           cfg.LogLevel,
+		iptablesVariant:    cfg.IptablesVariant,
 		k8sServiceHost:     cfg.K8sServiceHost,
 		k8sServicePort:     cfg.K8sServicePort,
 		k8sNodeName:        cfg.K8sNodeName,
@@ -85,7 +92,12 @@ func createCNIConfigFile(ctx context.Context, cfg *config.Config, saToken string
 		return "", err
 	}
 
-	cniConfig = replaceCNIConfigVars(cniConfig, getCNIConfigVars(cfg), saToken)
+	vars := getCNIConfigVars(cfg)
+	if vars.iptablesVariant == iptablesconstants.IptablesVariantAuto {
+		// The CNI plugin runs for every pod, so the variant in use on the host is detected here instead
+		vars.iptablesVariant = detectIptablesVariant()
+	}
+	cniConfig = replaceCNIConfigVars(cniConfig, vars, saToken)
 
 	return writeCNIConfig(ctx, cniConfig, getPluginConfig(cfg))
 }
@@ -112,6 +124,7 @@ func replaceCNIConfigVars(cniConfig []byte, vars cniConfigVars, saToken string)
 	cniConfigStr := string(cniConfig)
 
 	cniConfigStr = strings.ReplaceAll(cniConfigStr, "__LOG_LEVEL__", vars.logLevel)
+	cniConfigStr = strings.ReplaceAll(cniConfigStr, "__IPTABLES_VARIANT__", vars.iptablesVariant)
 	cniConfigStr = strings.ReplaceAll(cniConfigStr, "__KUBECONFIG_FILENAME__", vars.kubeconfigFilename)
 	cniConfigStr = strings.ReplaceAll(cniConfigStr, "__KUBECONFIG_FILEPATH__", filepath.Join(vars.cniNetDir, vars.kubeconfigFilename))
 	cniConfigStr = strings.ReplaceAll(cniConfigStr, "__KUBERNETES_SERVICE_HOST__", vars.k8sServiceHost)
@@ -400,6 +400,9 @@ const (
 )
 
 func TestCreateCNIConfigFile(t *testing.T) {
+	defer func(detect func() string) { detectIptablesVariant = detect }(detectIptablesVariant)
+	detectIptablesVariant = func() string { return "" }
+
 	cases := []struct {
 		name              string
 		chainedCNIPlugin  bool
@@ -548,3 +551,68 @@ func TestCreateCNIConfigFile(t *testing.T) {
 		t.Run(c.name, test(cfg))
 	}
 }
+
+func TestCreateCNIConfigFileIptablesVariant(t *testing.T) {
+	defer func(detect func() string) { detectIptablesVariant = detect }(detectIptablesVariant)
+	detections := 0
+	detectIptablesVariant = func() string {
+		detections++
+		return "nft"
+	}
+
+	cases := []struct {
+		name               string
+		iptablesVariant    string
+		expectedVariant    string
+		expectedDetections int
+	}{
+		{
+			name:               "detected variant",
+			iptablesVariant:    "auto",
+			expectedVariant:    "nft",
+			expectedDetections: 1,
+		},
+		{
+			name: "default binaries",
+		},
+		{
+			name:            "explicit variant",
+			iptablesVariant: "legacy",
+			expectedVariant: "legacy",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			detections = 0
+			tempDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				if err := os.RemoveAll(tempDir); err != nil {
+					t.Fatal(err)
+				}
+			}()
+
+			cfg := config.Config{
+				MountedCNINetDir:   tempDir,
+				CNINetworkConfig:   `{"type": "istio-cni", "iptables_variant": "__IPTABLES_VARIANT__"}`,
+				IptablesVariant:    c.iptablesVariant,
+				KubeconfigFilename: kubeconfigFilename,
+			}
+			resultFilepath, err := createCNIConfigFile(context.Background(), &cfg, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := fmt.Sprintf(`{"type": "istio-cni", "iptables_variant": "%s"}`, c.expectedVariant)
+			if actual := string(testutils.ReadFile(resultFilepath, t)); actual != expected {
+				t.Fatalf("expected %s, got %s", expected, actual)
+			}
+			if detections != c.expectedDetections {
+				t.Fatalf("expected %d detections, got %d", c.expectedDetections, detections)
+			}
+		})
+	}
+}
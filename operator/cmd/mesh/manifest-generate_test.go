@@ -15,6 +15,7 @@
 package mesh
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -211,6 +212,40 @@ func TestManifestGenerateIstiodRemote(t *testing.T) {
 	}
 }
 
+func TestManifestGenerateCNIHardening(t *testing.T) {
+	g := NewWithT(t)
+
+	objss, err := runManifestCommands("cni_hardening", "", liveCharts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, objs := range objss {
+		cm := objs.kind(name.CMStr).nameEquals("istio-cni-config")
+		g.Expect(cm).Should(Not(BeNil()))
+		cniConfig := make(map[string]interface{})
+		g.Expect(json.Unmarshal([]byte(mustGetValueAtPath(g, cm.Unstructured(), "data.cni_network_config").(string)), &cniConfig)).Should(Succeed())
+		g.Expect(cniConfig).Should(HavePathValueEqual(PathValue{"drop_invalid", true}))
+		g.Expect(cniConfig).Should(HavePathValueEqual(PathValue{"drop_martian_sources", true}))
+	}
+}
+
+func TestManifestGenerateCNIIptablesVariant(t *testing.T) {
+	g := NewWithT(t)
+
+	objss, err := runManifestCommands("cni_iptables_variant", "", liveCharts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, objs := range objss {
+		ds := objs.kind(name.DaemonSetStr).nameEquals("istio-cni-node")
+		g.Expect(ds).Should(Not(BeNil()))
+		c := ds.Container("install-cni")
+		g.Expect(c).Should(HavePathValueEqual(PathValue{"env.[name:IPTABLES_VARIANT].value", "auto"}))
+	}
+}
+
 func TestManifestGenerateAllOff(t *testing.T) {
 	g := NewWithT(t)
 	m, _, err := generateManifest("all_off", "", liveCharts)
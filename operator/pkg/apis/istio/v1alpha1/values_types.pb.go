@@ -232,6 +232,12 @@ type CNIConfig struct {
 	Repair               *CNIRepairConfig        `protobuf:"bytes,13,opt,name=repair,proto3" json:"repair,omitempty"`
 	Chained              *protobuf.BoolValue     `protobuf:"bytes,14,opt,name=chained,proto3" json:"chained,omitempty"`
 	Taint                *CNITaintConfig         `protobuf:"bytes,15,opt,name=taint,proto3" json:"taint,omitempty"`
+	// Controls whether packets in the INVALID conntrack state are dropped.
+	DropInvalid          bool                    `protobuf:"varint,16,opt,name=dropInvalid,proto3" json:"dropInvalid,omitempty"`
+	// Controls whether packets with a loopback source address arriving on a non-loopback interface are dropped.
+	DropMartianSources   bool                    `protobuf:"varint,17,opt,name=dropMartianSources,proto3" json:"dropMartianSources,omitempty"`
+	// The iptables variant (legacy, nft or auto) used to program the pod network namespace.
+	IptablesVariant      string                  `protobuf:"bytes,18,opt,name=iptablesVariant,proto3" json:"iptablesVariant,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -367,6 +373,27 @@ func (m *CNIConfig) GetTaint() *CNITaintConfig {
 	return nil
 }
 
+func (m *CNIConfig) GetDropInvalid() bool {
+	if m != nil {
+		return m.DropInvalid
+	}
+	return false
+}
+
+func (m *CNIConfig) GetDropMartianSources() bool {
+	if m != nil {
+		return m.DropMartianSources
+	}
+	return false
+}
+
+func (m *CNIConfig) GetIptablesVariant() string {
+	if m != nil {
+		return m.IptablesVariant
+	}
+	return ""
+}
+
 type CNITaintConfig struct {
 	// Controls whether taint behavior is enabled.
 	Enabled              *protobuf.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
@@ -4256,295 +4283,296 @@ func init() {
 }
 
 var fileDescriptor_261260e22432516f = []byte{
-	// 4627 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0x39, 0x77, 0x1c, 0x47,
-	0x7a, 0x1c, 0xdc, 0xf3, 0xcd, 0x0c, 0x30, 0x28, 0x1c, 0x6c, 0x42, 0x10, 0x09, 0xf5, 0x4a, 0x5c,
-	0x88, 0xd4, 0x82, 0x14, 0x84, 0x25, 0x29, 0xae, 0xa4, 0x15, 0x4e, 0x09, 0x5a, 0x00, 0x1c, 0xf7,
-	0x80, 0xd4, 0xb1, 0xde, 0xa5, 0x1b, 0xdd, 0x85, 0x41, 0x09, 0x3d, 0x5d, 0xed, 0xee, 0x9a, 0x01,
-	0x46, 0x89, 0x9f, 0x23, 0x47, 0x76, 0xe0, 0x1f, 0x60, 0x07, 0x0e, 0x1c, 0x39, 0xf6, 0xf3, 0x3f,
-	0x70, 0xe4, 0xb7, 0x89, 0x73, 0x3f, 0x45, 0xeb, 0xd0, 0x81, 0xdf, 0x06, 0x4e, 0xfc, 0xea, 0xe8,
-	0x73, 0x7a, 0x0e, 0x00, 0x92, 0xed, 0xe7, 0x08, 0xd3, 0xdf, 0x55, 0xd5, 0x55, 0x5f, 0x7d, 0x57,
-	0x7d, 0x0d, 0x78, 0xe0, 0x9d, 0x37, 0x1e, 0x99, 0x1e, 0x09, 0x1e, 0x91, 0x80, 0x11, 0xfa, 0xa8,
-	0xfd, 0xbe, 0xe9, 0x78, 0x67, 0xe6, 0xfb, 0x8f, 0xda, 0xa6, 0xd3, 0xc2, 0xc1, 0x6b, 0xd6, 0xf1,
-	0x70, 0xb0, 0xe6, 0xf9, 0x94, 0x51, 0x34, 0x15, 0x22, 0x97, 0xee, 0x36, 0x28, 0x6d, 0x38, 0xf8,
-	0x91, 0x80, 0x9f, 0xb4, 0x4e, 0x1f, 0xd9, 0x2d, 0xdf, 0x64, 0x84, 0xba, 0x92, 0x72, 0xe9, 0xd3,
-	0x06, 0x61, 0x67, 0xad, 0x93, 0x35, 0x8b, 0x36, 0x1f, 0x35, 0x68, 0x83, 0xc6, 0x84, 0xd1, 0x8f,
-	0xac, 0x84, 0x0b, 0xdf, 0xf4, 0x3c, 0xec, 0xab, 0xb1, 0x96, 0xe6, 0x39, 0x9b, 0xf8, 0x29, 0x04,
-	0x48, 0xa8, 0x6e, 0x00, 0x6c, 0xfa, 0xd6, 0xd9, 0x36, 0x75, 0x4f, 0x49, 0x03, 0xcd, 0xc3, 0xb8,
-	0xd9, 0xb4, 0x9f, 0x6c, 0x68, 0x85, 0x95, 0xc2, 0x6a, 0xc5, 0x90, 0x0f, 0x48, 0x83, 0x49, 0xcf,
-	0xb3, 0x9e, 0x6c, 0x38, 0x58, 0x1b, 0x11, 0xf0, 0xf0, 0x91, 0xd3, 0x07, 0x1f, 0x7c, 0xf8, 0xf8,
-	0x52, 0x1b, 0x95, 0xf4, 0xe2, 0x41, 0xff, 0xc3, 0x18, 0x14, 0xb7, 0x8f, 0xf6, 0x95, 0xcc, 0x0d,
-	0x98, 0xc4, 0xae, 0x79, 0xe2, 0x60, 0x5b, 0x48, 0x2d, 0xad, 0x2f, 0xad, 0xc9, 0x99, 0xae, 0x85,
-	0x33, 0x5d, 0xdb, 0xa2, 0xd4, 0x79, 0xc5, 0x57, 0xc7, 0x08, 0x49, 0x51, 0x15, 0x46, 0xcf, 0x5a,
-	0x27, 0x62, 0xbc, 0xa2, 0xc1, 0x7f, 0xa2, 0x77, 0x61, 0x94, 0x99, 0x0d, 0x31, 0x52, 0x69, 0xfd,
-	0xf6, 0x5a, 0xb8, 0x72, 0x6b, 0xc7, 0x1d, 0x0f, 0xef, 0xbb, 0x0c, 0xfb, 0xa7, 0xa6, 0x85, 0x0d,
-	0x4e, 0xc3, 0xa7, 0x45, 0x9a, 0x66, 0x03, 0x6b, 0x63, 0x82, 0x5d, 0x3e, 0xa0, 0xbb, 0x00, 0x5e,
-	0xcb, 0x71, 0x6a, 0xd4, 0x21, 0x56, 0x47, 0x1b, 0x17, 0xa8, 0x04, 0x04, 0x2d, 0x43, 0xd1, 0x72,
-	0xc9, 0x16, 0x71, 0x77, 0x88, 0xaf, 0x4d, 0x08, 0x74, 0x0c, 0xe0, 0xdc, 0x96, 0x4b, 0xf8, 0x3b,
-	0x71, 0xf4, 0xa4, 0xe4, 0x8e, 0x21, 0x68, 0x15, 0x66, 0xd4, 0xd3, 0x1e, 0x71, 0xf0, 0x91, 0xd9,
-	0xc4, 0xda, 0x94, 0x20, 0xca, 0x82, 0xd1, 0x7b, 0x30, 0x8b, 0x2f, 0x2d, 0xa7, 0x65, 0x8b, 0xc7,
-	0xc0, 0x33, 0x2d, 0x1c, 0x68, 0xc5, 0x95, 0xd1, 0xd5, 0xa2, 0xd1, 0x8d, 0x40, 0x07, 0x30, 0xed,
-	0x51, 0x7b, 0xd3, 0x75, 0x29, 0x13, 0xfa, 0x10, 0x68, 0x20, 0x56, 0x60, 0x25, 0xbd, 0x02, 0x87,
-	0xa6, 0x57, 0x67, 0x3e, 0x71, 0x1b, 0xd1, 0x52, 0x6c, 0x8d, 0x68, 0x05, 0x23, 0xc3, 0x8b, 0x56,
-	0xa1, 0xea, 0x05, 0xde, 0x6b, 0xcb, 0x69, 0x05, 0x0c, 0xfb, 0xaf, 0x7d, 0xea, 0x60, 0xad, 0x24,
-	0xa6, 0x39, 0xed, 0x05, 0xde, 0xb6, 0x04, 0x1b, 0xd4, 0xc1, 0x68, 0x09, 0xa6, 0x1c, 0xda, 0x38,
-	0xc0, 0x6d, 0xec, 0x68, 0x65, 0x41, 0x11, 0x3d, 0xa3, 0xf7, 0x61, 0xc2, 0xc7, 0x9e, 0x49, 0x7c,
-	0xad, 0x22, 0xe6, 0x72, 0x27, 0x9e, 0xcb, 0xf6, 0xd1, 0xbe, 0x21, 0x50, 0x72, 0xf7, 0x0d, 0x45,
-	0xc8, 0xb5, 0xc0, 0x3a, 0x33, 0x89, 0x8b, 0x6d, 0x6d, 0x7a, 0xb0, 0x16, 0x28, 0x52, 0xb4, 0x06,
-	0xe3, 0xcc, 0x24, 0x2e, 0xd3, 0x66, 0x04, 0x8f, 0x96, 0x1a, 0xe7, 0x98, 0x63, 0xd4, 0x30, 0x92,
-	0x4c, 0xdf, 0x83, 0xe9, 0x34, 0xe2, 0x7a, 0xda, 0xa7, 0xff, 0xd5, 0x28, 0xcc, 0x64, 0xde, 0xe4,
-	0xff, 0x8e, 0x1e, 0x2f, 0x43, 0xd1, 0x31, 0x4f, 0xb0, 0x53, 0xa3, 0x76, 0x20, 0xd4, 0x78, 0xca,
-	0x88, 0x01, 0xe8, 0x3e, 0x94, 0x2d, 0x1f, 0x9b, 0x0c, 0xef, 0xb6, 0xb1, 0xcb, 0x02, 0xa9, 0xc8,
-	0x42, 0x17, 0x52, 0x70, 0xae, 0xcf, 0x36, 0x76, 0x30, 0xc3, 0x42, 0xcc, 0xa4, 0x10, 0x93, 0x80,
-	0x70, 0x2d, 0x3d, 0xf1, 0xe9, 0x39, 0x76, 0x6b, 0xd4, 0x3e, 0xe0, 0xd2, 0x7f, 0x85, 0x3b, 0x4a,
-	0xa3, 0xbb, 0x11, 0xe8, 0x31, 0xcc, 0xa5, 0x81, 0x62, 0x19, 0xb4, 0xa2, 0xa0, 0xcf, 0x43, 0x71,
-	0xf9, 0xc4, 0x25, 0x7c, 0x9b, 0xf8, 0xd6, 0x61, 0x5f, 0x9c, 0x18, 0x90, 0xf2, 0xbb, 0x10, 0xfa,
-	0x57, 0xb0, 0xb4, 0x5d, 0x7b, 0x79, 0x6c, 0xfa, 0x0d, 0xcc, 0x5e, 0x32, 0xe2, 0x90, 0xef, 0x84,
-	0x42, 0xab, 0xad, 0x79, 0x0e, 0x1a, 0x13, 0xa8, 0xcd, 0x36, 0xf6, 0xcd, 0x06, 0x4e, 0x50, 0x88,
-	0xbd, 0x1a, 0x37, 0x7a, 0xe2, 0xf5, 0xff, 0x2a, 0x40, 0xd1, 0xc0, 0x01, 0x6d, 0xf9, 0xfc, 0xb4,
-	0x3d, 0x85, 0x09, 0x87, 0x34, 0x09, 0x0b, 0xb4, 0xc2, 0xca, 0xe8, 0x6a, 0x69, 0xfd, 0x5e, 0xbc,
-	0x3f, 0x11, 0xd1, 0xda, 0x81, 0xa0, 0xd8, 0x75, 0x99, 0xdf, 0x31, 0x14, 0x39, 0xfa, 0x18, 0xa6,
-	0x7c, 0xfc, 0xa7, 0x2d, 0x1c, 0xb0, 0x40, 0x1b, 0x11, 0xac, 0x6f, 0xe5, 0xb1, 0x1a, 0x8a, 0x46,
-	0x32, 0x47, 0x2c, 0x4b, 0x1f, 0x42, 0x29, 0x21, 0x95, 0x6b, 0xcd, 0x39, 0xee, 0x88, 0xb9, 0x17,
-	0x0d, 0xfe, 0x93, 0xab, 0x82, 0xf0, 0x1f, 0x4a, 0x93, 0xe4, 0xc3, 0xf3, 0x91, 0x67, 0x85, 0xa5,
-	0x5f, 0x40, 0x25, 0x25, 0xf5, 0x2a, 0xcc, 0xfa, 0x57, 0xb0, 0xb2, 0x83, 0x4f, 0xcd, 0x96, 0xc3,
-	0x6a, 0xd4, 0xde, 0x21, 0x81, 0xdf, 0xf2, 0xf8, 0xaa, 0x6c, 0xb5, 0xec, 0x06, 0xbe, 0xd9, 0x11,
-	0xfa, 0x12, 0x16, 0x95, 0xe4, 0xe8, 0xed, 0x95, 0xbc, 0xe4, 0x52, 0x49, 0x81, 0x79, 0x4b, 0x15,
-	0xbe, 0x93, 0x3a, 0xe0, 0x11, 0x8b, 0xfe, 0x1f, 0x65, 0x98, 0xdb, 0x6d, 0xf8, 0x38, 0x08, 0x3e,
-	0x33, 0x19, 0xbe, 0x30, 0x3b, 0x4a, 0xec, 0x1e, 0x54, 0xcd, 0x16, 0xa3, 0x81, 0x65, 0x3a, 0x78,
-	0x77, 0xe8, 0xf9, 0x76, 0xf1, 0x20, 0x1d, 0xca, 0x11, 0xec, 0xd0, 0xbc, 0x54, 0x2e, 0x2f, 0x05,
-	0x4b, 0xd3, 0x10, 0x57, 0xb9, 0xbf, 0x14, 0x0c, 0x3d, 0x87, 0x51, 0xcb, 0x6b, 0x89, 0x03, 0x5a,
-	0x5a, 0x7f, 0x3b, 0x61, 0xb9, 0x7a, 0xea, 0xb1, 0x38, 0xa5, 0x9c, 0x29, 0xb9, 0xe4, 0x93, 0xc3,
-	0xdb, 0x9a, 0x75, 0x18, 0xc5, 0x6e, 0x5b, 0x1c, 0xd2, 0x21, 0xfc, 0x83, 0xc1, 0x89, 0xd1, 0x26,
-	0x4c, 0x08, 0xdb, 0x21, 0x3d, 0x50, 0x69, 0xfd, 0xdd, 0x98, 0x2d, 0x67, 0x91, 0xd7, 0xc4, 0x01,
-	0x8e, 0x54, 0x5f, 0x3c, 0x20, 0x04, 0x63, 0x2e, 0x3f, 0xbc, 0x77, 0x84, 0x72, 0x89, 0xdf, 0xe8,
-	0x73, 0x28, 0xbb, 0xd4, 0xc6, 0x75, 0xec, 0x60, 0x8b, 0x51, 0xff, 0x4a, 0x3e, 0x2b, 0xc5, 0x99,
-	0xe3, 0xff, 0x4a, 0x37, 0xf0, 0x7f, 0x14, 0x96, 0x05, 0x84, 0x91, 0xcd, 0xd3, 0x53, 0x6e, 0x66,
-	0x3a, 0xe2, 0x8d, 0xa2, 0x79, 0x96, 0x85, 0xec, 0x9f, 0xa6, 0x65, 0xd7, 0x1d, 0x62, 0xe1, 0x17,
-	0xa7, 0x3d, 0x86, 0xe8, 0x2b, 0x10, 0x5d, 0xc0, 0x4a, 0x06, 0x7f, 0x8c, 0xfd, 0x66, 0x7a, 0xd0,
-	0xca, 0xd5, 0x07, 0x1d, 0x28, 0x14, 0x3d, 0x84, 0x71, 0x8f, 0xfa, 0x2c, 0xd0, 0xa6, 0xc5, 0xbe,
-	0x2e, 0xc4, 0xd2, 0x6b, 0x1c, 0x1c, 0xfa, 0x4d, 0x41, 0x83, 0x7e, 0x0e, 0x45, 0x3f, 0x3c, 0x78,
-	0xca, 0xd7, 0xce, 0xe5, 0x9c, 0x49, 0x31, 0x74, 0x4c, 0x89, 0x3e, 0x82, 0x4a, 0x80, 0x2d, 0x1f,
-	0xb3, 0x57, 0xd4, 0x69, 0x35, 0x71, 0xa0, 0x55, 0xc5, 0x58, 0x8b, 0x31, 0x6b, 0x3d, 0x81, 0x36,
-	0xd2, 0xc4, 0xa8, 0x06, 0x28, 0xc0, 0x7e, 0x9b, 0x58, 0x38, 0xb9, 0xbb, 0xb3, 0x43, 0x6a, 0x6f,
-	0x0e, 0x2f, 0xd7, 0x44, 0x1e, 0x5d, 0x6b, 0x48, 0x6a, 0x22, 0xff, 0x8d, 0x1e, 0xc2, 0xd8, 0x77,
-	0x6d, 0xcf, 0xd5, 0xe6, 0xb2, 0xfe, 0xf6, 0x1b, 0xec, 0xd3, 0x57, 0xb5, 0x23, 0xb5, 0x10, 0x82,
-	0x08, 0x1d, 0x42, 0x89, 0x51, 0x07, 0xfb, 0x6a, 0x2e, 0xf3, 0x57, 0xdf, 0x98, 0x24, 0x3f, 0x3a,
-	0x80, 0x19, 0x9f, 0x3a, 0x0e, 0x71, 0x1b, 0x87, 0xe6, 0x65, 0xbd, 0xe5, 0x37, 0xb0, 0xb6, 0x20,
-	0x44, 0xde, 0xed, 0x72, 0xfb, 0x2f, 0x7c, 0x29, 0x6d, 0x8f, 0xfa, 0xb5, 0x2d, 0x21, 0x29, 0xcb,
-	0x8a, 0xbe, 0x82, 0x85, 0x18, 0xf4, 0xd2, 0x35, 0xdb, 0x26, 0x71, 0xf8, 0xc1, 0xd7, 0x16, 0x87,
-	0x96, 0x99, 0x2f, 0x00, 0x1d, 0x42, 0xc5, 0x12, 0xcb, 0x10, 0xee, 0xe3, 0xed, 0x2b, 0xbd, 0xb8,
-	0x91, 0xe6, 0x46, 0xbf, 0x86, 0x79, 0xd3, 0xb6, 0x09, 0x5f, 0x03, 0xd3, 0x89, 0xfc, 0x78, 0xa0,
-	0x69, 0x57, 0x93, 0x9a, 0x2b, 0x04, 0x3d, 0x83, 0xa2, 0xdf, 0x72, 0x37, 0x03, 0x83, 0x52, 0xa6,
-	0x2d, 0x0d, 0x34, 0x8e, 0x31, 0xb1, 0xf0, 0xb1, 0xb1, 0xf9, 0xba, 0x92, 0x9b, 0xfc, 0xf7, 0x02,
-	0x4c, 0x2b, 0x43, 0x18, 0x7a, 0xb1, 0x23, 0x98, 0x13, 0xf9, 0xdd, 0x6b, 0x2c, 0xcc, 0x64, 0x43,
-	0x62, 0x95, 0xc7, 0x79, 0xb3, 0xaf, 0x15, 0x35, 0x90, 0xe0, 0xdc, 0x4d, 0x32, 0x26, 0x4d, 0xfe,
-	0xc8, 0xf0, 0x26, 0xff, 0x8f, 0x60, 0x5e, 0xce, 0x82, 0xb8, 0xa9, 0x69, 0x8c, 0x65, 0x55, 0x62,
-	0xdf, 0xcd, 0x99, 0x87, 0x7c, 0x83, 0xfd, 0x14, 0xab, 0xfe, 0xfb, 0x59, 0x28, 0x7f, 0xe6, 0xd0,
-	0x13, 0xb1, 0xea, 0xfc, 0x4d, 0x57, 0x61, 0xcc, 0xf4, 0xad, 0x33, 0xf5, 0x6a, 0xf3, 0xb1, 0xcc,
-	0x38, 0x71, 0x34, 0x04, 0x05, 0x8f, 0x02, 0xa5, 0x26, 0xf0, 0xf5, 0x8e, 0x72, 0x18, 0x6d, 0x5d,
-	0x46, 0x81, 0x39, 0x28, 0xee, 0xb4, 0x95, 0xee, 0x98, 0x0e, 0xb1, 0x65, 0xc4, 0x36, 0x3a, 0xd8,
-	0x69, 0x67, 0x79, 0xd0, 0xe7, 0x70, 0xcf, 0x96, 0xd1, 0x86, 0x9c, 0xd0, 0x2b, 0x12, 0x90, 0x13,
-	0xe2, 0x10, 0xd6, 0xa9, 0x63, 0xc6, 0x88, 0xdb, 0x08, 0xb4, 0x0d, 0x91, 0x61, 0x0d, 0x22, 0x43,
-	0xaf, 0x60, 0x4e, 0x91, 0x1c, 0x25, 0x1d, 0xd8, 0xc4, 0x15, 0x9c, 0x4e, 0x9e, 0x00, 0xe4, 0xc2,
-	0x92, 0xdd, 0x33, 0xd2, 0x52, 0x5e, 0xfe, 0x41, 0x2c, 0x7e, 0x50, 0x54, 0x26, 0x06, 0xea, 0x23,
-	0x11, 0xd5, 0xa0, 0x6a, 0x67, 0xe2, 0x2f, 0x11, 0x8e, 0xa7, 0x5e, 0x22, 0x3f, 0x42, 0x13, 0xb2,
-	0xbb, 0xb8, 0xd1, 0xaf, 0x01, 0x29, 0xd8, 0x71, 0xc2, 0x46, 0x3e, 0xbd, 0xba, 0x8d, 0xcc, 0x11,
-	0x13, 0xe6, 0x49, 0xe5, 0x38, 0x4f, 0x5a, 0x85, 0x19, 0x91, 0xef, 0xd4, 0xe2, 0x9c, 0xbd, 0x22,
-	0x13, 0xea, 0x0c, 0x18, 0x3d, 0x80, 0x6a, 0x04, 0x92, 0x0e, 0x27, 0xd0, 0xde, 0x11, 0xbb, 0xdd,
-	0x05, 0x47, 0xf7, 0x61, 0x5a, 0x28, 0x7d, 0xac, 0x9d, 0xd3, 0x32, 0xfd, 0x4d, 0x43, 0xb9, 0x99,
-	0x71, 0x68, 0x63, 0x33, 0xf8, 0x22, 0xa0, 0xae, 0xf6, 0xf6, 0x60, 0x33, 0x13, 0x11, 0xa3, 0xa7,
-	0x30, 0xe9, 0xd0, 0x46, 0x83, 0xb8, 0x0d, 0xe5, 0xcb, 0x12, 0xc6, 0x40, 0x9e, 0xab, 0x03, 0x89,
-	0x56, 0x47, 0x27, 0xa4, 0x46, 0xdb, 0x50, 0x69, 0xe2, 0xe0, 0x6c, 0xf7, 0xd2, 0x33, 0xdd, 0x80,
-	0x1f, 0x04, 0x94, 0x65, 0x3f, 0x4c, 0xa2, 0x15, 0x7b, 0x9a, 0x07, 0x2d, 0xc2, 0x04, 0x07, 0xec,
-	0xef, 0x68, 0x3f, 0x17, 0xef, 0xa5, 0x9e, 0xd0, 0x0e, 0x94, 0xf9, 0xaf, 0x23, 0xcc, 0x2e, 0xa8,
-	0x7f, 0x1e, 0x28, 0x77, 0x38, 0xd8, 0xcd, 0xa6, 0xb8, 0xd0, 0xa7, 0x50, 0x6e, 0xb6, 0x1c, 0x46,
-	0x54, 0xa1, 0x40, 0x79, 0x9e, 0xe5, 0xc4, 0x0c, 0x13, 0x58, 0x35, 0xc1, 0x14, 0x07, 0xd2, 0x60,
-	0xd2, 0x95, 0xd2, 0xb4, 0x9f, 0x8a, 0x09, 0x86, 0x8f, 0xe8, 0x09, 0x2c, 0x7a, 0xd4, 0xde, 0x39,
-	0xaa, 0xd7, 0x31, 0x37, 0x26, 0x89, 0xda, 0xc8, 0x43, 0xb1, 0x97, 0x3d, 0xb0, 0xe8, 0xb7, 0xb0,
-	0x4c, 0x9b, 0x84, 0xd5, 0x89, 0x8d, 0x2d, 0xd3, 0xdf, 0x77, 0xbf, 0x15, 0xe7, 0x4d, 0x0e, 0x7e,
-	0x68, 0x7a, 0xda, 0xfd, 0x81, 0x9b, 0xd7, 0x97, 0x1f, 0x7d, 0x02, 0x65, 0xea, 0xc6, 0x15, 0x19,
-	0xe5, 0x1b, 0xfb, 0xc9, 0x4b, 0xd1, 0x23, 0x03, 0x16, 0xa9, 0xc7, 0xf5, 0x9c, 0xfa, 0x87, 0xa6,
-	0x6b, 0x36, 0xf0, 0x97, 0xf8, 0xe4, 0x8c, 0xd2, 0xf3, 0x40, 0x7b, 0x77, 0xa0, 0xa4, 0x1e, 0x9c,
-	0xe8, 0x31, 0xcc, 0x7a, 0x3e, 0xa1, 0x3e, 0x61, 0x9d, 0x6d, 0xc7, 0x0c, 0x02, 0x91, 0x3c, 0xbf,
-	0x11, 0x65, 0xfa, 0xdd, 0x48, 0x11, 0x0e, 0xfa, 0xf4, 0xb2, 0xa3, 0x2d, 0x8b, 0x41, 0x93, 0xe1,
-	0x20, 0x07, 0x47, 0xe1, 0x20, 0x7f, 0x40, 0x4f, 0xa1, 0x28, 0x7e, 0xec, 0xbb, 0x84, 0x69, 0x6f,
-	0x66, 0x4b, 0x3c, 0xb5, 0x10, 0xa5, 0x98, 0x62, 0x5a, 0xf4, 0x0e, 0x8c, 0x06, 0x76, 0xa0, 0xdd,
-	0xcd, 0x46, 0x90, 0xf5, 0x9d, 0xba, 0x22, 0xe6, 0xf8, 0xb0, 0x04, 0x72, 0x6f, 0x88, 0x12, 0xc8,
-	0x1a, 0x4c, 0x30, 0xdf, 0xb4, 0xb0, 0xaf, 0xbd, 0x25, 0xa8, 0x13, 0xb1, 0xe5, 0xb1, 0x80, 0x87,
-	0x75, 0x26, 0x49, 0x85, 0x56, 0xa0, 0xc4, 0xfc, 0x56, 0xc0, 0x76, 0x68, 0xd3, 0x24, 0xae, 0xa6,
-	0x0b, 0x1d, 0x4b, 0x82, 0xd0, 0x3a, 0x4c, 0xb4, 0x02, 0x7c, 0xb8, 0x5d, 0xd3, 0x7e, 0x32, 0x70,
-	0xfd, 0x15, 0x25, 0x5a, 0x03, 0xe4, 0xe3, 0x26, 0x65, 0xb8, 0x46, 0x1c, 0xca, 0x36, 0x6d, 0x9b,
-	0x3b, 0x4c, 0xed, 0xb1, 0x10, 0x9e, 0x83, 0xe1, 0xb3, 0x16, 0xf6, 0xc4, 0xd6, 0x9e, 0x64, 0x67,
-	0xbd, 0x2f, 0xe0, 0xe1, 0xac, 0x25, 0x15, 0x7a, 0x0f, 0x66, 0x3d, 0xce, 0xbf, 0x8d, 0x7d, 0x56,
-	0xf3, 0x69, 0x9b, 0xd8, 0xd8, 0xd7, 0x9e, 0xc9, 0x62, 0x48, 0x17, 0x02, 0x2d, 0x43, 0xf1, 0xdb,
-	0x0b, 0xa6, 0x6c, 0xe2, 0x87, 0xb2, 0x50, 0x19, 0x01, 0xc4, 0x1e, 0xb0, 0x40, 0x7b, 0xde, 0xb5,
-	0x07, 0xc7, 0xf1, 0x1e, 0xb0, 0x00, 0x2d, 0xf1, 0x2c, 0xbc, 0x4d, 0x84, 0xa1, 0xf9, 0x85, 0xac,
-	0xef, 0x85, 0xcf, 0x68, 0x0b, 0xa6, 0x9b, 0xb4, 0xe5, 0xb2, 0x43, 0xe6, 0x04, 0x7c, 0xe4, 0x40,
-	0xfb, 0x68, 0xe0, 0x52, 0x65, 0x38, 0x44, 0x35, 0xd5, 0x0c, 0x57, 0xea, 0x63, 0x55, 0x4d, 0x0d,
-	0x01, 0x7c, 0x04, 0x7c, 0xc9, 0xb0, 0xef, 0x9a, 0x8e, 0x5c, 0x10, 0xed, 0x93, 0xc1, 0x23, 0xa4,
-	0x39, 0xd0, 0xa7, 0x50, 0xb1, 0xb0, 0xcb, 0xfc, 0x48, 0xc4, 0x2f, 0x07, 0x8a, 0x48, 0x33, 0xe8,
-	0x3f, 0x83, 0x62, 0xb4, 0x2a, 0x5c, 0x73, 0x54, 0x4a, 0xc1, 0x13, 0x24, 0x55, 0x01, 0x4f, 0x82,
-	0x74, 0x03, 0xca, 0xc9, 0xdd, 0x13, 0x2f, 0x21, 0xe2, 0xb0, 0x4d, 0xd7, 0x74, 0x3a, 0x01, 0x09,
-	0x86, 0x88, 0xdc, 0x32, 0x1c, 0xfa, 0x43, 0x98, 0xcb, 0x71, 0x0a, 0x3c, 0x14, 0x75, 0x44, 0xe9,
-	0x55, 0x86, 0xa7, 0xf2, 0x41, 0xff, 0xbb, 0x2a, 0xcc, 0xe7, 0x05, 0x72, 0xff, 0xaf, 0x6a, 0x1f,
-	0x7c, 0x5b, 0x5b, 0x01, 0xa3, 0xcd, 0xba, 0x5c, 0x7a, 0x15, 0x7a, 0xf5, 0xdf, 0xd6, 0x24, 0x43,
-	0x32, 0x94, 0x86, 0x2b, 0x57, 0x4f, 0x4a, 0x57, 0xa9, 0x9e, 0x6c, 0x45, 0xd5, 0x93, 0x19, 0x91,
-	0xf9, 0x3e, 0xe8, 0x1f, 0x70, 0xe7, 0x96, 0x4f, 0xee, 0xc3, 0xb4, 0x43, 0x4d, 0x7b, 0xcb, 0x74,
-	0x4c, 0xd7, 0xc2, 0xfe, 0x7e, 0x4d, 0xab, 0xca, 0x88, 0x24, 0x0d, 0x45, 0xcf, 0x41, 0x4b, 0x42,
-	0xea, 0x22, 0x2a, 0x33, 0x4c, 0xb7, 0x81, 0x79, 0xd2, 0xcc, 0x3d, 0x64, 0x4f, 0x3c, 0xda, 0x05,
-	0x94, 0x0a, 0x13, 0x44, 0x09, 0x40, 0x43, 0xfd, 0x2a, 0x03, 0x39, 0x0c, 0x51, 0xa5, 0xe7, 0xbd,
-	0x3e, 0x95, 0x9e, 0xb9, 0x1f, 0xb0, 0xd2, 0x33, 0xff, 0x23, 0x56, 0x7a, 0x16, 0xfe, 0x37, 0x2a,
-	0x3d, 0x8b, 0x3f, 0x6a, 0xa5, 0xe7, 0xf6, 0x10, 0x95, 0x9e, 0xfb, 0x50, 0xf6, 0xb1, 0xe7, 0x10,
-	0xcb, 0xdc, 0xe6, 0xf6, 0x5a, 0xe4, 0xe4, 0x15, 0xb9, 0x19, 0x49, 0x38, 0xda, 0x4a, 0x56, 0x84,
-	0xee, 0x5c, 0x61, 0x1f, 0xfa, 0x95, 0x87, 0xde, 0xb8, 0x79, 0x79, 0x68, 0xf9, 0x07, 0x28, 0x0f,
-	0xbd, 0x99, 0x28, 0x0f, 0x3d, 0x51, 0xe5, 0x21, 0x19, 0xb2, 0xe8, 0xbd, 0xce, 0xef, 0x37, 0x6d,
-	0xcf, 0x4d, 0x55, 0x8a, 0x72, 0x4a, 0x3b, 0xf7, 0x7e, 0x84, 0xd2, 0xce, 0xca, 0x4d, 0x4b, 0x3b,
-	0x1b, 0xb0, 0x10, 0xba, 0xcd, 0x63, 0xdf, 0x3c, 0x3d, 0x25, 0x96, 0x8a, 0x1b, 0x64, 0x64, 0x94,
-	0x8f, 0xcc, 0xd6, 0xc1, 0x7e, 0x72, 0xc3, 0x3a, 0xd8, 0xaf, 0xa0, 0xac, 0xea, 0x13, 0xd2, 0xf0,
-	0xbc, 0x7d, 0xb5, 0x42, 0x50, 0x8a, 0xb9, 0x67, 0x75, 0xe9, 0x9d, 0x1f, 0xa2, 0xba, 0xd4, 0x55,
-	0x09, 0xbb, 0x7f, 0xa3, 0x4a, 0x58, 0xaa, 0x58, 0xf5, 0xb3, 0xff, 0xa1, 0x62, 0xd5, 0x19, 0x68,
-	0xbd, 0x94, 0xf7, 0x9a, 0x97, 0x98, 0x8b, 0x30, 0x11, 0xb4, 0x4e, 0x4f, 0xc9, 0xa5, 0x1a, 0x4c,
-	0x3d, 0xe9, 0x7f, 0x06, 0x73, 0x39, 0x29, 0xe9, 0x35, 0x07, 0x91, 0x71, 0xf9, 0xfe, 0xc1, 0xd6,
-	0x10, 0x51, 0x94, 0xa2, 0xd4, 0x7f, 0x5f, 0x00, 0xd4, 0x9d, 0x72, 0x5e, 0x73, 0x02, 0x2b, 0x50,
-	0x52, 0xf7, 0xe2, 0x22, 0x9d, 0x92, 0xaf, 0x9a, 0x04, 0xf1, 0x34, 0xa0, 0x21, 0x82, 0x35, 0x99,
-	0x4a, 0xd4, 0xe5, 0x9a, 0x8c, 0xca, 0x34, 0xa0, 0x1b, 0x83, 0xbe, 0x00, 0x44, 0x5c, 0x71, 0xa1,
-	0xbf, 0xeb, 0xb6, 0x69, 0x67, 0x8f, 0x38, 0x3c, 0x69, 0x1e, 0x1b, 0x38, 0xa5, 0x1c, 0x2e, 0xfd,
-	0x2f, 0x0a, 0xf0, 0xc6, 0x8b, 0x16, 0x3b, 0xa1, 0x2d, 0xd7, 0x4e, 0x1d, 0x56, 0xf5, 0xce, 0x9f,
-	0xc0, 0x58, 0x93, 0xda, 0x72, 0xda, 0xd3, 0xc9, 0x40, 0xa4, 0x0f, 0xd3, 0xda, 0x21, 0xb5, 0xb1,
-	0x21, 0xf8, 0xf4, 0x55, 0x18, 0xe3, 0x4f, 0xa8, 0x02, 0xc5, 0xcd, 0x83, 0x83, 0x17, 0x5f, 0xbe,
-	0xde, 0x3c, 0xfa, 0xba, 0x7a, 0x0b, 0xcd, 0x42, 0xc5, 0xd8, 0xfd, 0x6c, 0xbf, 0x7e, 0x6c, 0x7c,
-	0xfd, 0xfa, 0xc5, 0xd1, 0xc1, 0xd7, 0xd5, 0x82, 0xfe, 0x87, 0x32, 0x94, 0x44, 0xb6, 0x73, 0xa3,
-	0xd5, 0xce, 0x0b, 0x59, 0x47, 0x6e, 0x1a, 0xb2, 0xf6, 0x08, 0x47, 0xb3, 0x61, 0xed, 0x58, 0x4e,
-	0x58, 0x9b, 0x75, 0x8c, 0xe3, 0x3d, 0x1c, 0x63, 0x74, 0x27, 0x3f, 0x91, 0xbc, 0x93, 0x7f, 0x1b,
-	0x2a, 0x22, 0x01, 0xad, 0x9b, 0x4d, 0x8f, 0x5b, 0x61, 0x71, 0x09, 0x57, 0x30, 0xd2, 0xc0, 0xf4,
-	0x35, 0x4b, 0x71, 0xe8, 0x6b, 0x96, 0x55, 0x98, 0x91, 0x66, 0x25, 0x2e, 0x42, 0x80, 0x6a, 0x2d,
-	0x49, 0x83, 0xc3, 0xb8, 0xbb, 0x74, 0x9d, 0xb8, 0x3b, 0x1b, 0xc8, 0x95, 0xaf, 0x1d, 0xc8, 0x59,
-	0x70, 0xef, 0x1c, 0x63, 0xcf, 0x74, 0x48, 0x9b, 0x2f, 0x2d, 0x0f, 0xcb, 0xc5, 0xd1, 0x74, 0xb1,
-	0xc5, 0x07, 0xde, 0x6c, 0xe0, 0xa8, 0x6f, 0x24, 0xbb, 0xd3, 0x3b, 0xaa, 0xeb, 0xc9, 0x18, 0x24,
-	0x01, 0x1d, 0x40, 0xd5, 0xc6, 0x9e, 0x43, 0x3b, 0x4d, 0xec, 0x32, 0x69, 0x2a, 0x55, 0x67, 0xc9,
-	0xe0, 0xe0, 0xa0, 0x8b, 0x93, 0x1b, 0x6a, 0x2b, 0xaa, 0x18, 0xa1, 0xc1, 0x86, 0x3a, 0x22, 0x4e,
-	0x94, 0x13, 0xe6, 0x87, 0x2e, 0x27, 0xa8, 0x54, 0x63, 0xe1, 0x2a, 0xa9, 0x46, 0x4e, 0xc0, 0xa1,
-	0xfd, 0x08, 0x01, 0xc7, 0x9d, 0x9b, 0xdf, 0x25, 0xa5, 0x42, 0x87, 0xa5, 0x1b, 0x86, 0x0e, 0x67,
-	0xf0, 0x96, 0xb4, 0x18, 0x35, 0xbe, 0x9c, 0x16, 0x75, 0xea, 0x2e, 0xe1, 0x91, 0x30, 0x9f, 0x48,
-	0x68, 0xd9, 0x54, 0x50, 0xd8, 0x6f, 0xe5, 0x07, 0x0b, 0x41, 0xa7, 0xb0, 0xd2, 0x93, 0x68, 0xdf,
-	0x95, 0x03, 0xbd, 0x39, 0x70, 0xa0, 0x81, 0x32, 0x72, 0xd2, 0x9c, 0xbb, 0x37, 0x48, 0x73, 0x7e,
-	0x09, 0x65, 0xa9, 0x8b, 0x32, 0xdf, 0x53, 0x41, 0xe8, 0x1b, 0x89, 0x1c, 0x20, 0xb6, 0xd4, 0x2a,
-	0x25, 0x4c, 0x31, 0xa0, 0x67, 0x70, 0xfb, 0xdb, 0x8b, 0xf3, 0x80, 0x1b, 0x1f, 0xa7, 0x8d, 0xfd,
-	0xdd, 0x4b, 0xe6, 0x9b, 0x3c, 0x02, 0xd9, 0xde, 0x14, 0xc1, 0x67, 0xd1, 0xe8, 0x85, 0x46, 0x1f,
-	0xc0, 0xa4, 0xe7, 0xb4, 0x1a, 0xc4, 0x0d, 0x54, 0x6d, 0xee, 0x4e, 0xce, 0x2e, 0xcb, 0x77, 0x30,
-	0x42, 0xca, 0xb0, 0xce, 0xaf, 0x77, 0xf5, 0x43, 0xfd, 0x64, 0x70, 0x31, 0x50, 0xff, 0xc7, 0x02,
-	0x20, 0xf1, 0x3e, 0x2a, 0xbe, 0x51, 0x0e, 0xe8, 0x3e, 0x4c, 0xab, 0x08, 0x31, 0x2c, 0x19, 0x14,
-	0x54, 0x4d, 0x3f, 0x05, 0x45, 0x2f, 0x61, 0x81, 0x44, 0x8c, 0x8c, 0xab, 0x2f, 0xf6, 0x0f, 0x63,
-	0x9f, 0x99, 0xe8, 0xf5, 0xc9, 0x25, 0x33, 0xf2, 0xb9, 0xb9, 0x77, 0x09, 0x11, 0x8e, 0x19, 0x04,
-	0x2a, 0x1e, 0x48, 0xc1, 0xf4, 0x7d, 0x98, 0x15, 0x13, 0x4f, 0xb9, 0xec, 0xeb, 0x35, 0xd6, 0x30,
-	0x98, 0x39, 0xc6, 0x0e, 0x6e, 0x62, 0xe6, 0xdf, 0x48, 0x10, 0x7a, 0x08, 0x23, 0xed, 0x75, 0x75,
-	0xdb, 0x96, 0x50, 0x98, 0x48, 0xf8, 0xab, 0x75, 0x95, 0xf1, 0x8c, 0xb4, 0xd7, 0xf5, 0xbf, 0x1e,
-	0x85, 0xd9, 0x2e, 0xcc, 0x35, 0x07, 0xfe, 0x0a, 0x66, 0x9b, 0x98, 0x99, 0xb6, 0xc9, 0xcc, 0xd7,
-	0xf8, 0xd2, 0x3a, 0x33, 0x5d, 0xd5, 0xe2, 0x56, 0x5a, 0x7f, 0x98, 0x3b, 0x8f, 0x43, 0x45, 0xbd,
-	0xab, 0x88, 0xd5, 0xbc, 0xaa, 0xcd, 0x0c, 0x1c, 0xed, 0x02, 0x78, 0x3e, 0x6d, 0x62, 0x76, 0x86,
-	0x5b, 0x61, 0x35, 0xee, 0x9d, 0x5c, 0x91, 0xb5, 0x88, 0x4c, 0x09, 0x4b, 0x30, 0xa2, 0xcf, 0xa1,
-	0x14, 0x30, 0xd3, 0x3a, 0xb7, 0x7d, 0xd2, 0xc6, 0xbe, 0x5a, 0xa2, 0xfb, 0xb9, 0x72, 0xea, 0x9c,
-	0x6e, 0x47, 0xd0, 0x29, 0x41, 0x49, 0x56, 0xf4, 0xc7, 0x30, 0x6b, 0x5a, 0x16, 0x0e, 0x82, 0xd7,
-	0x0e, 0x6d, 0xbc, 0xf6, 0xe2, 0xd6, 0xd3, 0xd2, 0xfa, 0xe3, 0x5c, 0x79, 0x9b, 0x82, 0xfa, 0x80,
-	0x36, 0xa4, 0xa6, 0xc8, 0xe0, 0x4f, 0x49, 0x9e, 0x31, 0xd3, 0x48, 0xdd, 0x84, 0xb7, 0x06, 0xae,
-	0x12, 0xfa, 0x08, 0x4a, 0x17, 0x66, 0xd0, 0x1c, 0x3e, 0xc6, 0x4a, 0x92, 0xeb, 0xff, 0x3a, 0x0a,
-	0x6f, 0xf4, 0x59, 0xb6, 0x6b, 0x6a, 0xc0, 0x8d, 0xe6, 0x84, 0x7e, 0x13, 0xc6, 0x43, 0xaf, 0x69,
-	0x1b, 0xfb, 0x3e, 0xb1, 0xb1, 0xda, 0xa2, 0x8d, 0xa1, 0xb6, 0x7a, 0x4d, 0xfe, 0x79, 0xa1, 0x78,
-	0x8d, 0x69, 0x2b, 0xf5, 0xbc, 0xf4, 0x7d, 0x01, 0xa6, 0xd3, 0x24, 0xe8, 0x39, 0x4c, 0xa6, 0x2f,
-	0xf8, 0x07, 0x3b, 0xed, 0x90, 0x01, 0x7d, 0xce, 0xad, 0x93, 0x30, 0xfd, 0xea, 0x8a, 0x49, 0xbd,
-	0xee, 0x60, 0x11, 0x19, 0x3e, 0xf4, 0x05, 0xcc, 0x50, 0xe5, 0xad, 0x42, 0x51, 0xa3, 0x43, 0x8a,
-	0xca, 0x32, 0xea, 0x7f, 0x33, 0x0e, 0xcb, 0xfd, 0xd4, 0xf8, 0x9a, 0x1b, 0xfb, 0x2c, 0xbe, 0xfc,
-	0x1c, 0xb8, 0xa9, 0xc2, 0x9f, 0x45, 0xb7, 0x9f, 0xcf, 0x01, 0x9a, 0xd4, 0x25, 0x8c, 0xf2, 0x89,
-	0x0f, 0xd1, 0x03, 0x90, 0xa0, 0x46, 0x4f, 0x60, 0x8a, 0x51, 0x8f, 0x3a, 0xb4, 0xd1, 0x19, 0x22,
-	0xbb, 0x8a, 0x68, 0xd1, 0x0e, 0xcc, 0xd8, 0x24, 0xe0, 0x33, 0x8f, 0x42, 0x89, 0xc1, 0xc5, 0xe6,
-	0x2c, 0x0b, 0xdf, 0xe0, 0xb4, 0x06, 0xa9, 0x03, 0x3e, 0xc4, 0x06, 0xa7, 0xf9, 0xd0, 0xb7, 0xb0,
-	0x10, 0xee, 0x53, 0x64, 0x07, 0xc4, 0x5a, 0x4e, 0x0a, 0x07, 0xb5, 0x31, 0x9c, 0x05, 0x5a, 0x4b,
-	0xf1, 0x1a, 0xf9, 0x22, 0xd1, 0x19, 0xcc, 0x2b, 0xf5, 0x4a, 0x0f, 0x35, 0x75, 0x83, 0xa1, 0x72,
-	0x25, 0xea, 0x1b, 0x50, 0x49, 0x0f, 0x3d, 0x05, 0x63, 0x47, 0x2f, 0x8e, 0x76, 0xab, 0xb7, 0xf8,
-	0xaf, 0xbd, 0x97, 0x07, 0x07, 0xd5, 0x02, 0x9a, 0x81, 0xd2, 0xae, 0x61, 0xbc, 0x30, 0xea, 0x32,
-	0xcb, 0x1c, 0xd1, 0xff, 0xbe, 0x00, 0xf7, 0x87, 0xb3, 0x8b, 0xd7, 0x54, 0xd5, 0xcf, 0x60, 0xd6,
-	0xa1, 0x8d, 0x2f, 0x89, 0x6b, 0xd3, 0x8b, 0x30, 0xed, 0x50, 0x4a, 0xdb, 0x27, 0x2f, 0xe9, 0xe6,
-	0xd1, 0x77, 0x95, 0x6f, 0x4f, 0x06, 0x59, 0xe8, 0x31, 0xcc, 0x05, 0xad, 0x93, 0xc0, 0xf2, 0xc9,
-	0x09, 0xb6, 0xe3, 0x0e, 0x8c, 0x82, 0x28, 0xd4, 0xe7, 0xa1, 0xf4, 0xbf, 0x2c, 0x40, 0x29, 0x51,
-	0xb0, 0x8d, 0x8a, 0xed, 0x85, 0x44, 0xb1, 0x1d, 0xc1, 0x98, 0x47, 0x7d, 0x26, 0xa6, 0x39, 0x6e,
-	0x88, 0xdf, 0x68, 0x09, 0xa6, 0x78, 0xf6, 0x25, 0x2e, 0xad, 0x46, 0x05, 0x3c, 0x7a, 0x46, 0x77,
-	0x01, 0x64, 0xe3, 0xb3, 0xc0, 0x8e, 0x09, 0x6c, 0x02, 0xc2, 0x79, 0x3d, 0x15, 0xa9, 0xaa, 0x0f,
-	0x22, 0xa2, 0x67, 0xfd, 0x5f, 0x26, 0xa1, 0x94, 0xb8, 0x1b, 0xe6, 0xb2, 0x78, 0xc2, 0x2c, 0x2f,
-	0xc8, 0x55, 0x47, 0x7a, 0x02, 0xc2, 0x53, 0x60, 0x55, 0x2b, 0x51, 0x77, 0xaf, 0x52, 0x60, 0x1a,
-	0x88, 0xde, 0x83, 0x59, 0x8b, 0x36, 0x3d, 0xea, 0xf2, 0xdc, 0x2b, 0xfc, 0xbe, 0x40, 0xa6, 0xd2,
-	0xdd, 0x88, 0xf8, 0x86, 0x6d, 0x9b, 0xfa, 0x78, 0xa7, 0xd5, 0xf4, 0x54, 0xd6, 0x3c, 0xc4, 0x0d,
-	0x5b, 0xc8, 0xc1, 0x77, 0x42, 0x7d, 0x55, 0xa1, 0x22, 0x70, 0x59, 0x83, 0x94, 0x9d, 0x26, 0x79,
-	0x28, 0x9e, 0x6f, 0x87, 0xe0, 0x9a, 0xba, 0x60, 0x51, 0x9d, 0x27, 0x19, 0x70, 0x5c, 0x0c, 0x98,
-	0x4e, 0x16, 0x03, 0x56, 0x61, 0x46, 0x15, 0x70, 0x22, 0xfe, 0xaa, 0xea, 0x5c, 0x49, 0x83, 0x53,
-	0x1f, 0x59, 0xa0, 0xcc, 0x47, 0x16, 0xcf, 0x79, 0x2c, 0x43, 0xda, 0xc4, 0xc1, 0x0d, 0x6c, 0xab,
-	0x6b, 0x95, 0xbe, 0x06, 0x31, 0xa6, 0x46, 0x5b, 0xb0, 0xec, 0x63, 0xd3, 0x26, 0x2e, 0x0e, 0x82,
-	0x7d, 0x97, 0x30, 0x62, 0x3a, 0x3b, 0xd8, 0x31, 0x3b, 0x75, 0x6c, 0x51, 0xd7, 0x96, 0x17, 0x2b,
-	0x15, 0xa3, 0x2f, 0x0d, 0x7a, 0x02, 0x8b, 0x11, 0xbe, 0x86, 0x7d, 0x42, 0xed, 0x90, 0x7b, 0x41,
-	0x70, 0xf7, 0xc0, 0xa2, 0x8f, 0xe0, 0x4e, 0x84, 0xd9, 0x33, 0x89, 0xd3, 0xf2, 0xf1, 0xf1, 0x99,
-	0x8f, 0x83, 0x33, 0xea, 0xd8, 0xe2, 0x02, 0xa4, 0x62, 0xf4, 0x26, 0xe0, 0x5a, 0x16, 0x30, 0x93,
-	0xb5, 0x44, 0xb1, 0x57, 0xf4, 0x5a, 0x54, 0x8c, 0x04, 0x24, 0x5d, 0x42, 0xd1, 0xae, 0x50, 0x42,
-	0x09, 0xdb, 0x08, 0xee, 0x08, 0xfb, 0x56, 0x8d, 0x79, 0x24, 0x3c, 0x6a, 0x20, 0x58, 0x87, 0x79,
-	0xb5, 0xcb, 0xa1, 0x81, 0x97, 0xfa, 0xb2, 0x2c, 0xb6, 0x27, 0x17, 0x87, 0x3e, 0x81, 0xa2, 0x43,
-	0x4e, 0xb1, 0xd5, 0xb1, 0x1c, 0xac, 0x8a, 0xdb, 0x83, 0x8d, 0x7f, 0xcc, 0x82, 0x6c, 0xb8, 0xc7,
-	0x5f, 0x7e, 0xd3, 0x13, 0x75, 0x26, 0x6e, 0x54, 0x5e, 0xba, 0x8c, 0x38, 0xe2, 0xf4, 0xd5, 0x99,
-	0xe9, 0xb3, 0xb0, 0xba, 0xdd, 0x6f, 0xff, 0x07, 0x89, 0xd0, 0x7f, 0x0b, 0x33, 0x99, 0xd6, 0x8d,
-	0x58, 0x7f, 0x0b, 0x49, 0xfd, 0x4d, 0xad, 0xf1, 0xf8, 0xb0, 0x6b, 0xac, 0x6f, 0xc3, 0xed, 0x1e,
-	0xdd, 0xfb, 0x3c, 0xeb, 0xb3, 0xbc, 0x56, 0x58, 0xbe, 0xb6, 0xbc, 0x96, 0xec, 0x53, 0x6a, 0x52,
-	0xbf, 0x13, 0x96, 0x94, 0xe5, 0x93, 0xfe, 0x19, 0x14, 0xa3, 0x66, 0x11, 0xf4, 0x1c, 0xc6, 0x19,
-	0x3d, 0xc7, 0xee, 0xb0, 0x0e, 0x55, 0xcc, 0x48, 0xb2, 0xe8, 0x7f, 0x02, 0xe5, 0xe4, 0xed, 0x12,
-	0x5a, 0x86, 0xa2, 0xe8, 0x50, 0xa8, 0x99, 0xec, 0x4c, 0x4d, 0x24, 0x06, 0x44, 0xc6, 0x76, 0x24,
-	0x61, 0x6c, 0xb9, 0x2a, 0x0a, 0x09, 0xa2, 0x1c, 0x2c, 0xb3, 0xba, 0x04, 0x44, 0xff, 0xdb, 0x02,
-	0x54, 0x54, 0x6a, 0x19, 0x35, 0x04, 0x94, 0xcc, 0x44, 0x5e, 0x3f, 0x6c, 0xa8, 0x98, 0x64, 0xe2,
-	0xd9, 0x64, 0x78, 0x27, 0x53, 0x0b, 0x4d, 0x7d, 0xc5, 0x48, 0xc1, 0xa2, 0xd9, 0x8e, 0xa6, 0x5d,
-	0x43, 0xb6, 0xf7, 0x59, 0xff, 0xa7, 0x31, 0x58, 0xc8, 0xed, 0x6b, 0x42, 0x5f, 0xc1, 0x1d, 0x69,
-	0x26, 0xe3, 0x46, 0xaa, 0xad, 0x8e, 0xea, 0x06, 0x1c, 0x22, 0x1c, 0xef, 0xcd, 0x8c, 0xbe, 0x86,
-	0x39, 0x17, 0xb7, 0xb1, 0x1a, 0x30, 0xaa, 0x26, 0x96, 0xae, 0x76, 0x8f, 0x92, 0x27, 0x43, 0xdc,
-	0xfc, 0x38, 0x17, 0x66, 0x27, 0xc8, 0xc8, 0x2e, 0x5f, 0xf5, 0xe6, 0x27, 0x47, 0x08, 0x3a, 0x80,
-	0x39, 0x1f, 0x5f, 0xf8, 0x84, 0xe1, 0x4d, 0xcf, 0xfb, 0xfc, 0xf8, 0xb8, 0x56, 0xf3, 0xe9, 0x09,
-	0x16, 0x86, 0xbb, 0xff, 0x5a, 0xe4, 0xb1, 0x21, 0x03, 0xe6, 0x88, 0x90, 0x8f, 0x53, 0x95, 0x9e,
-	0x61, 0xbb, 0xee, 0xf2, 0x98, 0x79, 0x9c, 0x49, 0x4f, 0x52, 0x2f, 0x3e, 0x6c, 0x01, 0x31, 0xc3,
-	0x27, 0x2b, 0x16, 0xdf, 0xca, 0x5a, 0xea, 0x4b, 0xe3, 0x40, 0x58, 0x65, 0x51, 0xb1, 0x88, 0x61,
-	0xfa, 0x9f, 0x8f, 0x40, 0x39, 0xd9, 0x61, 0x85, 0x9e, 0xc2, 0x24, 0xcf, 0x2e, 0x6d, 0xda, 0xe8,
-	0x6e, 0x72, 0x96, 0x84, 0x3b, 0x12, 0x1d, 0xf6, 0x35, 0x2a, 0x6a, 0xf4, 0x31, 0xb7, 0x8e, 0x8d,
-	0x33, 0x16, 0x30, 0xec, 0x29, 0xdd, 0xba, 0x97, 0x65, 0x3d, 0xe0, 0x04, 0x75, 0x86, 0xbd, 0xb0,
-	0xa7, 0x2c, 0xe2, 0x40, 0x1b, 0x30, 0xf1, 0x1d, 0xf1, 0xce, 0x49, 0xd8, 0x18, 0xbc, 0x9c, 0xe5,
-	0xfd, 0x46, 0x60, 0xc3, 0x8e, 0x2a, 0x49, 0x8b, 0xb6, 0xd3, 0x29, 0xfc, 0x58, 0xf6, 0x3b, 0x23,
-	0xc9, 0x5a, 0x8f, 0x49, 0x72, 0xb2, 0x77, 0xfd, 0x11, 0xcc, 0xe5, 0xbc, 0x19, 0xd2, 0x60, 0xd2,
-	0x54, 0x8d, 0x4d, 0xd2, 0x90, 0x84, 0x8f, 0x7a, 0x1d, 0x16, 0x72, 0xdf, 0xa7, 0x37, 0x0b, 0x5a,
-	0x81, 0x92, 0x4c, 0xeb, 0x8f, 0x85, 0xa5, 0x53, 0xb7, 0x4e, 0x09, 0x90, 0xbe, 0x06, 0xa8, 0xfb,
-	0x45, 0xfb, 0x4c, 0xe2, 0x3f, 0x0b, 0x70, 0xbb, 0xc7, 0xeb, 0xa1, 0xc7, 0x30, 0x6e, 0xe3, 0x93,
-	0x56, 0x63, 0x88, 0x40, 0x59, 0x12, 0xa2, 0x0d, 0x58, 0x68, 0x9a, 0x97, 0x47, 0xad, 0xe6, 0x09,
-	0xf6, 0x5f, 0x9c, 0x6e, 0x32, 0xe6, 0x93, 0x93, 0x16, 0xc3, 0x81, 0x32, 0x4c, 0xf9, 0x48, 0x1e,
-	0x3c, 0x24, 0x11, 0x89, 0x23, 0x20, 0xef, 0x67, 0x7a, 0x60, 0xd1, 0x73, 0xd0, 0x12, 0x98, 0x43,
-	0x1c, 0x04, 0x66, 0x23, 0xfc, 0x92, 0x51, 0xde, 0xda, 0xf4, 0xc4, 0xeb, 0xbf, 0x2b, 0x00, 0x6c,
-	0x99, 0x41, 0x68, 0x8c, 0xbf, 0x00, 0xa4, 0x22, 0x41, 0x63, 0xe7, 0x18, 0x37, 0x3d, 0xc7, 0x64,
-	0x38, 0x18, 0xe2, 0xbd, 0x73, 0xb8, 0x78, 0x6c, 0xdb, 0x8e, 0x9a, 0xcd, 0xf9, 0x89, 0x91, 0xdb,
-	0x94, 0x06, 0xa2, 0x1a, 0x2c, 0x48, 0x5e, 0xd1, 0x25, 0x26, 0xa7, 0xb1, 0x6d, 0xec, 0x04, 0x43,
-	0x64, 0xb3, 0xf9, 0x8c, 0xfa, 0x33, 0x40, 0xb2, 0xe3, 0xcc, 0x10, 0x3d, 0x86, 0xea, 0xcd, 0xb2,
-	0xc7, 0xb7, 0x90, 0x73, 0x7c, 0xff, 0x61, 0x1c, 0x26, 0x84, 0xe8, 0x00, 0xbd, 0x03, 0xa3, 0x96,
-	0x4b, 0xd4, 0xc9, 0x9b, 0x4b, 0x7d, 0x42, 0x1b, 0x36, 0x04, 0x5a, 0x2e, 0x41, 0x1b, 0x30, 0xa5,
-	0x4a, 0x16, 0xa1, 0xd3, 0x4f, 0x7c, 0x6e, 0x9b, 0xfe, 0xf8, 0xc1, 0x88, 0x28, 0xd1, 0x1a, 0x4c,
-	0xc8, 0x8b, 0x4f, 0x95, 0x39, 0x2f, 0x66, 0x9b, 0x9d, 0xc3, 0x73, 0x29, 0xa9, 0x44, 0xb3, 0x0a,
-	0x4f, 0x96, 0x54, 0x67, 0xd6, 0x42, 0x6e, 0xa1, 0xda, 0x90, 0x34, 0xe8, 0x29, 0x14, 0x59, 0x98,
-	0x02, 0xaa, 0xbe, 0xdb, 0x3b, 0x39, 0x89, 0x69, 0x68, 0x33, 0x22, 0x5a, 0xf4, 0x25, 0x2c, 0x06,
-	0x69, 0xbf, 0xa7, 0x5a, 0x67, 0xd5, 0xc5, 0x53, 0xc2, 0xfe, 0xe4, 0xfa, 0x47, 0xa3, 0x07, 0xbb,
-	0xf8, 0x5e, 0x41, 0x7d, 0x24, 0x1d, 0x45, 0x48, 0xb3, 0x43, 0x7c, 0xaf, 0x90, 0xe1, 0x41, 0x8f,
-	0xa1, 0x28, 0xbf, 0xdb, 0xe0, 0x3b, 0x33, 0xd7, 0x7b, 0x67, 0xa6, 0x04, 0xd5, 0xb6, 0x4b, 0x52,
-	0xfd, 0x9a, 0x0b, 0x99, 0x7e, 0xcd, 0x65, 0x28, 0xd2, 0x8b, 0xf0, 0x1b, 0x5a, 0x69, 0xcc, 0x63,
-	0x00, 0x7a, 0x0a, 0xd0, 0xc4, 0x81, 0xfa, 0x52, 0x43, 0x85, 0xa7, 0x3d, 0xeb, 0xec, 0x09, 0x52,
-	0xb4, 0x0a, 0x63, 0x27, 0x66, 0x80, 0x55, 0xec, 0x99, 0xf8, 0xf0, 0x23, 0x3e, 0x65, 0x86, 0xa0,
-	0x40, 0x9f, 0x42, 0x99, 0x24, 0xf4, 0x54, 0x75, 0x4d, 0x2c, 0x67, 0xbb, 0x5e, 0x93, 0x5a, 0x6c,
-	0xa4, 0x38, 0x74, 0x0d, 0x16, 0xf3, 0x9d, 0x97, 0x7e, 0x0f, 0xde, 0xec, 0xeb, 0xcf, 0xf5, 0x45,
-	0x98, 0xcf, 0xbb, 0xa4, 0xd2, 0x67, 0x61, 0x26, 0x73, 0x0d, 0xa1, 0xff, 0x06, 0x2a, 0xa9, 0xcf,
-	0xbe, 0x7e, 0xe0, 0x7e, 0x88, 0x19, 0xa8, 0xa4, 0x56, 0xf3, 0xc1, 0x17, 0x3d, 0x6e, 0x1c, 0xd0,
-	0x0c, 0x94, 0x5e, 0x1e, 0xd5, 0x6b, 0xbb, 0xdb, 0xfb, 0x7b, 0xfb, 0xbb, 0x3b, 0xd5, 0x5b, 0xa8,
-	0x04, 0x93, 0x3b, 0xbb, 0x7b, 0x9b, 0x2f, 0x0f, 0x8e, 0xab, 0x05, 0x04, 0x30, 0x51, 0x3f, 0x36,
-	0xf6, 0xb7, 0x8f, 0xab, 0x23, 0x68, 0x12, 0x46, 0x5f, 0xec, 0xed, 0x55, 0x47, 0x1f, 0xbc, 0x0a,
-	0x53, 0x18, 0x8e, 0x96, 0x5e, 0xae, 0x7a, 0x0b, 0x55, 0x12, 0xce, 0xb5, 0x5a, 0xe0, 0x62, 0x94,
-	0xdb, 0xad, 0x8e, 0xf0, 0x41, 0x12, 0xde, 0xac, 0x3a, 0x8a, 0xe6, 0x60, 0x86, 0x7a, 0xd8, 0xdd,
-	0xc6, 0x6e, 0xd0, 0x0a, 0x36, 0x1b, 0xd8, 0x65, 0xd5, 0xb1, 0xad, 0xc5, 0x7f, 0xfe, 0xfe, 0xee,
-	0xad, 0xdf, 0x7d, 0x7f, 0xf7, 0xd6, 0xbf, 0x7d, 0x7f, 0xf7, 0xd6, 0x37, 0xd1, 0x7f, 0xa4, 0x38,
-	0x99, 0x10, 0x2b, 0xf0, 0xc1, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x66, 0x18, 0x38, 0xa2, 0xd0,
-	0x42, 0x00, 0x00,
+	// 4655 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdd, 0x3c, 0xc9, 0x72, 0x1c, 0xd9,
+	0x71, 0xd3, 0x68, 0xac, 0xd9, 0xdd, 0x40, 0xe3, 0x61, 0x61, 0x11, 0x04, 0xb7, 0x1a, 0x0e, 0x35,
+	0x43, 0x4a, 0xe0, 0x0c, 0x86, 0xa2, 0x38, 0x94, 0x66, 0xa4, 0x06, 0xd0, 0xa0, 0x30, 0xc2, 0xd2,
+	0xae, 0x06, 0xc8, 0x99, 0xb1, 0x25, 0xb8, 0xd0, 0xfd, 0xd0, 0x28, 0xb2, 0xba, 0xaa, 0x5c, 0x55,
+	0x8d, 0x65, 0x2e, 0x0e, 0x9f, 0x7c, 0xb2, 0x0e, 0xfe, 0x00, 0xeb, 0xa0, 0x83, 0x4f, 0x3e, 0x2b,
+	0xf4, 0x07, 0x3e, 0x39, 0x74, 0xf1, 0xdd, 0xa1, 0x93, 0x74, 0x54, 0x38, 0x1c, 0x3e, 0xf8, 0xe2,
+	0x7c, 0x4b, 0xad, 0x5d, 0xbd, 0x00, 0x98, 0xb1, 0x1d, 0x3e, 0x20, 0xd8, 0x2f, 0x5f, 0x66, 0xbe,
+	0x2d, 0x5f, 0x6e, 0x2f, 0x8b, 0xf0, 0xc8, 0x79, 0xdb, 0x7a, 0xa2, 0x3b, 0x86, 0xf7, 0xc4, 0xf0,
+	0x7c, 0xc3, 0x7e, 0x72, 0xfa, 0x91, 0x6e, 0x3a, 0x27, 0xfa, 0x47, 0x4f, 0x4e, 0x75, 0xb3, 0x43,
+	0xbd, 0x43, 0xff, 0xc2, 0xa1, 0xde, 0x8a, 0xe3, 0xda, 0xbe, 0x4d, 0x26, 0x83, 0xce, 0xa5, 0x3b,
+	0x2d, 0xdb, 0x6e, 0x99, 0xf4, 0x09, 0x87, 0x1f, 0x75, 0x8e, 0x9f, 0x34, 0x3b, 0xae, 0x8e, 0xe4,
+	0x96, 0xc0, 0x5c, 0xfa, 0x49, 0xcb, 0xf0, 0x4f, 0x3a, 0x47, 0x2b, 0x0d, 0xbb, 0xfd, 0xa4, 0x65,
+	0xb7, 0xec, 0x08, 0x31, 0xfc, 0x91, 0xe6, 0x70, 0xe6, 0xea, 0x8e, 0x43, 0x5d, 0x39, 0xd6, 0xd2,
+	0x3c, 0x23, 0xe3, 0x3f, 0x39, 0x03, 0x01, 0x55, 0x35, 0x80, 0x8a, 0xdb, 0x38, 0x59, 0xb7, 0xad,
+	0x63, 0xa3, 0x45, 0xe6, 0x61, 0x4c, 0x6f, 0x37, 0x9f, 0x3d, 0x55, 0x72, 0xf7, 0x72, 0xef, 0x97,
+	0x34, 0xd1, 0x20, 0x0a, 0x4c, 0x38, 0x4e, 0xe3, 0xd9, 0x53, 0x93, 0x2a, 0x23, 0x1c, 0x1e, 0x34,
+	0x19, 0xbe, 0xf7, 0xf1, 0x27, 0x1f, 0x9e, 0x2b, 0x79, 0x81, 0xcf, 0x1b, 0xea, 0xbf, 0x8f, 0xc1,
+	0xd4, 0xfa, 0xee, 0x96, 0xe4, 0xf9, 0x14, 0x26, 0xa8, 0xa5, 0x1f, 0x99, 0xb4, 0xc9, 0xb9, 0x16,
+	0x56, 0x97, 0x56, 0xc4, 0x4c, 0x57, 0x82, 0x99, 0xae, 0xac, 0xd9, 0xb6, 0xf9, 0x8a, 0xed, 0x8e,
+	0x16, 0xa0, 0x92, 0x32, 0xe4, 0x71, 0xb9, 0x7c, 0xbc, 0x29, 0x8d, 0xfd, 0x24, 0x1f, 0x40, 0xde,
+	0xd7, 0x5b, 0x7c, 0xa4, 0xc2, 0xea, 0x8d, 0x95, 0x60, 0xe7, 0x56, 0xf6, 0x71, 0x3f, 0xb7, 0x2c,
+	0x9f, 0xba, 0xc7, 0x7a, 0x83, 0x6a, 0x0c, 0x87, 0x4d, 0xcb, 0x68, 0xeb, 0x2d, 0xaa, 0x8c, 0x72,
+	0x72, 0xd1, 0x20, 0x77, 0x00, 0x9c, 0x8e, 0x69, 0xd6, 0x6c, 0xd3, 0x68, 0x5c, 0x28, 0x63, 0xbc,
+	0x2b, 0x06, 0x21, 0xcb, 0x30, 0xd5, 0xb0, 0x8c, 0x35, 0xc3, 0xda, 0x30, 0x5c, 0x65, 0x9c, 0x77,
+	0x47, 0x00, 0x46, 0x8d, 0x0d, 0xb6, 0x26, 0xd6, 0x3d, 0x21, 0xa8, 0x23, 0x08, 0x79, 0x1f, 0x66,
+	0x64, 0x6b, 0xd3, 0x30, 0xe9, 0xae, 0xde, 0xa6, 0xca, 0x24, 0x47, 0x4a, 0x83, 0xc9, 0x77, 0x61,
+	0x96, 0x9e, 0x37, 0xcc, 0x4e, 0x93, 0x37, 0x3d, 0x07, 0xa7, 0xed, 0x29, 0x53, 0xf7, 0xf2, 0x88,
+	0xdb, 0xdd, 0x41, 0xb6, 0x61, 0xda, 0xb1, 0x9b, 0x15, 0xcb, 0xb2, 0x7d, 0x2e, 0x0f, 0x9e, 0x02,
+	0x7c, 0x07, 0xee, 0x25, 0x77, 0x60, 0x47, 0x77, 0xea, 0xbe, 0x6b, 0x58, 0xad, 0x70, 0x2b, 0xd6,
+	0x46, 0x94, 0x9c, 0x96, 0xa2, 0xc5, 0x59, 0x96, 0x1d, 0xcf, 0x39, 0xc4, 0x41, 0x3c, 0x44, 0x3b,
+	0x74, 0x6d, 0x3c, 0xd3, 0x02, 0x9f, 0xe6, 0x34, 0xc2, 0xd7, 0x05, 0x58, 0x43, 0x28, 0x59, 0x82,
+	0x49, 0xd3, 0x6e, 0x6d, 0xd3, 0x53, 0x6a, 0x2a, 0x45, 0x8e, 0x11, 0xb6, 0xc9, 0x47, 0x30, 0xee,
+	0x52, 0x47, 0xc7, 0x7d, 0x28, 0xf1, 0xb9, 0xdc, 0x8c, 0xe6, 0x82, 0xe7, 0xae, 0xf1, 0x2e, 0x71,
+	0xfa, 0x9a, 0x44, 0x64, 0x52, 0xd0, 0x38, 0xd1, 0x0d, 0x0b, 0xa5, 0x60, 0x7a, 0xb0, 0x14, 0x48,
+	0x54, 0xb2, 0x02, 0x63, 0x3e, 0xfe, 0xf2, 0x95, 0x19, 0x4e, 0xa3, 0x24, 0xc6, 0xd9, 0x67, 0x3d,
+	0x72, 0x18, 0x81, 0x46, 0xee, 0x41, 0xa1, 0xe9, 0xda, 0xce, 0x96, 0x85, 0x77, 0xcd, 0x68, 0x2a,
+	0x65, 0xa4, 0x9a, 0xd4, 0xe2, 0x20, 0xe4, 0x48, 0x58, 0x73, 0x47, 0x77, 0x7d, 0x43, 0xb7, 0xea,
+	0x76, 0xc7, 0x65, 0xbb, 0x3f, 0xcb, 0x11, 0x33, 0x7a, 0xd8, 0xb1, 0x1a, 0x8e, 0xcf, 0x64, 0xd2,
+	0x7b, 0xa5, 0xbb, 0xd8, 0xe1, 0x2b, 0x44, 0x1c, 0x6b, 0x0a, 0xac, 0x6e, 0xc2, 0x74, 0x72, 0x52,
+	0x57, 0x93, 0x7c, 0xf5, 0x97, 0x79, 0x98, 0x49, 0xed, 0xe2, 0xff, 0x9d, 0x3b, 0x84, 0x77, 0xc4,
+	0xd4, 0x8f, 0x28, 0x5e, 0x99, 0xa6, 0xc7, 0xaf, 0xd0, 0xa4, 0x16, 0x01, 0xc8, 0x43, 0x28, 0x36,
+	0x5c, 0xaa, 0xfb, 0xb4, 0x7a, 0x4a, 0x2d, 0xdf, 0x13, 0x97, 0x88, 0xcb, 0x61, 0x02, 0xce, 0xee,
+	0x52, 0x93, 0x9a, 0xd4, 0xa7, 0x9c, 0xcd, 0x04, 0x67, 0x13, 0x83, 0xb0, 0x1b, 0x72, 0xe4, 0xda,
+	0x6f, 0xa9, 0x85, 0xad, 0x6d, 0xc6, 0xfd, 0x67, 0xf4, 0x42, 0xde, 0xa6, 0xee, 0x0e, 0xf2, 0x21,
+	0xcc, 0x25, 0x81, 0x7c, 0x1b, 0xf0, 0x46, 0x31, 0xfc, 0xac, 0x2e, 0xc6, 0xdf, 0xb0, 0x0c, 0x76,
+	0x4c, 0x4c, 0x6c, 0xa8, 0xcb, 0x6f, 0x2b, 0x08, 0xfe, 0x5d, 0x1d, 0xea, 0x17, 0xb0, 0xb4, 0x5e,
+	0x3b, 0xd8, 0xd7, 0xdd, 0x16, 0xf5, 0x0f, 0x7c, 0xc3, 0x34, 0xbe, 0xe6, 0x97, 0x49, 0x1e, 0xcd,
+	0x0b, 0x50, 0x7c, 0xde, 0x55, 0x39, 0xa5, 0x2e, 0x6e, 0x51, 0x0c, 0x83, 0x9f, 0xd5, 0x98, 0xd6,
+	0xb3, 0x5f, 0xfd, 0xaf, 0x1c, 0x4c, 0x69, 0xd4, 0x93, 0xa2, 0xf6, 0x03, 0x18, 0x37, 0x8d, 0xb6,
+	0x81, 0xfb, 0x96, 0x43, 0x65, 0x50, 0x58, 0xbd, 0x1b, 0x9d, 0x4f, 0x88, 0xb4, 0xb2, 0xcd, 0x31,
+	0xaa, 0x96, 0xef, 0x5e, 0x68, 0x12, 0x9d, 0x7c, 0x0a, 0x93, 0x2e, 0xfd, 0x2b, 0xb4, 0x2d, 0x48,
+	0x3a, 0xc2, 0x49, 0xef, 0x67, 0x91, 0x6a, 0x12, 0x47, 0x10, 0x87, 0x24, 0x4b, 0x9f, 0x40, 0x21,
+	0xc6, 0x95, 0x49, 0xcd, 0x5b, 0xdc, 0xee, 0x9c, 0x90, 0x1a, 0xfc, 0xc9, 0x44, 0x81, 0xdb, 0x2e,
+	0x29, 0x49, 0xa2, 0xf1, 0x62, 0xe4, 0x79, 0x6e, 0xe9, 0x87, 0x50, 0x4a, 0x70, 0xbd, 0x0c, 0x31,
+	0xee, 0xeb, 0xbd, 0x0d, 0x7a, 0xac, 0x77, 0x4c, 0x1f, 0x4f, 0x67, 0xc3, 0xf0, 0xdc, 0x8e, 0xc3,
+	0x76, 0x65, 0xad, 0xd3, 0xc4, 0xdd, 0xba, 0xd6, 0x15, 0x7a, 0x0d, 0x8b, 0x92, 0x73, 0xb8, 0x7a,
+	0xc9, 0x2f, 0xbe, 0x55, 0x82, 0x61, 0xd6, 0x56, 0x05, 0x6b, 0x92, 0xca, 0x25, 0x24, 0x51, 0xff,
+	0x54, 0x84, 0xb9, 0x6a, 0xcb, 0xa5, 0x9e, 0xf7, 0x12, 0xa5, 0xf9, 0x4c, 0xbf, 0x90, 0x6c, 0x37,
+	0xa1, 0xac, 0x77, 0x7c, 0xdb, 0x6b, 0xe8, 0x26, 0xad, 0x0e, 0x3d, 0xdf, 0x2e, 0x1a, 0xa2, 0x42,
+	0x31, 0x84, 0xed, 0xe8, 0xe7, 0xd2, 0xdc, 0x26, 0x60, 0x49, 0x1c, 0xc3, 0x92, 0xa6, 0x37, 0x01,
+	0x43, 0xa1, 0xcc, 0x37, 0x9c, 0x0e, 0xbf, 0xa0, 0x85, 0xd5, 0x07, 0x31, 0xad, 0xd9, 0x53, 0x8e,
+	0xf9, 0x2d, 0x65, 0x44, 0xf1, 0x2d, 0x9f, 0x18, 0x5e, 0xd7, 0xac, 0x42, 0x9e, 0x5a, 0xa7, 0xfc,
+	0x92, 0x0e, 0x61, 0x9b, 0x34, 0x86, 0x4c, 0x2a, 0x28, 0xf0, 0xec, 0x52, 0x0a, 0xeb, 0x57, 0x58,
+	0xfd, 0x20, 0x22, 0xcb, 0xd8, 0xe4, 0x15, 0x7e, 0x81, 0x43, 0xd1, 0xe7, 0x0d, 0x42, 0x60, 0xd4,
+	0x62, 0x97, 0xf7, 0x26, 0x17, 0x2e, 0xfe, 0x9b, 0xfc, 0x14, 0x8a, 0x96, 0xdd, 0xa4, 0x75, 0xd4,
+	0x27, 0x0d, 0xdf, 0x76, 0x2f, 0x65, 0x2f, 0x13, 0x94, 0x19, 0xb6, 0xb7, 0x70, 0x0d, 0xdb, 0x6b,
+	0xc3, 0x32, 0x87, 0xf8, 0x46, 0xe5, 0xf8, 0x98, 0xa9, 0x99, 0x0b, 0xbe, 0xa2, 0x70, 0x9e, 0x45,
+	0xce, 0xfb, 0x3b, 0x49, 0xde, 0x75, 0x74, 0x4d, 0xe8, 0xde, 0x71, 0x8f, 0x21, 0xfa, 0x32, 0x24,
+	0x67, 0x70, 0x2f, 0xd5, 0xbf, 0x4f, 0xdd, 0x76, 0x72, 0xd0, 0xd2, 0xe5, 0x07, 0x1d, 0xc8, 0x94,
+	0x3c, 0x86, 0x31, 0xc7, 0x76, 0xf1, 0x8a, 0x4d, 0xf3, 0x73, 0x5d, 0x88, 0xb8, 0xd7, 0x18, 0x38,
+	0xb0, 0xd9, 0x1c, 0x87, 0x7c, 0x1f, 0xa6, 0xdc, 0xe0, 0xe2, 0x49, 0x3b, 0x3f, 0x97, 0x71, 0x27,
+	0xf9, 0xd0, 0x11, 0x26, 0xf9, 0x11, 0x94, 0x3c, 0x8a, 0x56, 0xc5, 0x7f, 0x65, 0x9b, 0x1d, 0xf4,
+	0x96, 0xd0, 0xd8, 0xb3, 0xb1, 0x16, 0x23, 0xd2, 0x7a, 0xac, 0x5b, 0x4b, 0x22, 0x93, 0x1a, 0x10,
+	0x8f, 0xba, 0xa7, 0xb8, 0xcc, 0xf8, 0xe9, 0xce, 0x0e, 0x29, 0xbd, 0x19, 0xb4, 0x4c, 0x12, 0x99,
+	0x67, 0x2f, 0xbd, 0x03, 0xfe, 0x1b, 0xf7, 0x61, 0xf4, 0xeb, 0x53, 0xc7, 0x52, 0xe6, 0xd2, 0xf6,
+	0xf6, 0x2b, 0xea, 0xda, 0xaf, 0x6a, 0xbb, 0x72, 0x23, 0x38, 0x12, 0xd9, 0x81, 0x82, 0x8f, 0x8e,
+	0x97, 0x2b, 0xe7, 0x32, 0x7f, 0xf9, 0x83, 0x89, 0xd3, 0xa3, 0xec, 0xce, 0xa0, 0x77, 0x67, 0x22,
+	0x12, 0x2a, 0x8d, 0x7a, 0x07, 0x2f, 0xbd, 0xb2, 0xc0, 0x59, 0xde, 0xe9, 0x32, 0xfb, 0x7b, 0xae,
+	0xe0, 0xb6, 0x69, 0xbb, 0xb5, 0x35, 0xce, 0x29, 0x4d, 0x4a, 0xbe, 0x80, 0x85, 0x08, 0x74, 0x60,
+	0xe9, 0xa7, 0xba, 0x61, 0xb2, 0x8b, 0xaf, 0x2c, 0x0e, 0xcd, 0x33, 0x9b, 0x01, 0x2e, 0xbb, 0xd4,
+	0xe0, 0xdb, 0x10, 0x9c, 0xe3, 0x8d, 0x4b, 0x2d, 0x5c, 0x4b, 0x52, 0x93, 0x3f, 0x87, 0x79, 0xbd,
+	0xd9, 0x34, 0xd8, 0x1e, 0xe8, 0x66, 0x68, 0xc7, 0x3d, 0x45, 0xb9, 0x1c, 0xd7, 0x4c, 0x26, 0xe4,
+	0x39, 0x8a, 0x6a, 0xc7, 0xaa, 0x78, 0x9a, 0x6d, 0xfb, 0xca, 0xd2, 0x40, 0xe5, 0x18, 0x21, 0x73,
+	0x1b, 0x1b, 0xa9, 0xaf, 0x4b, 0x99, 0xc9, 0x3f, 0xe6, 0x60, 0x5a, 0x2a, 0xc2, 0xc0, 0x8a, 0xed,
+	0xc2, 0x1c, 0x8f, 0x2d, 0x0f, 0x29, 0x57, 0x93, 0x2d, 0xd1, 0x2b, 0x2d, 0xce, 0xed, 0xbe, 0x5a,
+	0x54, 0x23, 0x9c, 0xb2, 0x1a, 0x27, 0x8c, 0xab, 0xfc, 0x91, 0xe1, 0x55, 0xfe, 0x9f, 0xc1, 0xbc,
+	0x98, 0x05, 0xee, 0x5c, 0x7c, 0x1a, 0xa3, 0x69, 0x91, 0xd8, 0xb2, 0x32, 0xe6, 0x21, 0x56, 0xb0,
+	0x95, 0x20, 0x55, 0xff, 0x30, 0x0b, 0xc5, 0x97, 0xa6, 0x7d, 0xc4, 0x77, 0x9d, 0xad, 0xf4, 0x7d,
+	0x18, 0xd5, 0x31, 0x3c, 0x95, 0x4b, 0x9b, 0x8f, 0x78, 0x46, 0x41, 0xab, 0xc6, 0x31, 0x98, 0x17,
+	0x28, 0x24, 0x81, 0xed, 0x77, 0x18, 0x3f, 0x29, 0xab, 0xc2, 0x0b, 0xcc, 0xe8, 0x62, 0x46, 0x5b,
+	0xca, 0x0e, 0x8b, 0x0c, 0x84, 0xc7, 0x96, 0x1f, 0x6c, 0xb4, 0xd3, 0x34, 0x68, 0x6f, 0xee, 0x36,
+	0x85, 0xb7, 0x21, 0x26, 0xf4, 0xca, 0xf0, 0x8c, 0x23, 0x34, 0xaf, 0xfe, 0x45, 0x9d, 0xfa, 0x3e,
+	0x6e, 0x8e, 0xa7, 0x3c, 0xe5, 0xd1, 0xdd, 0x20, 0x34, 0xf2, 0x0a, 0xe6, 0x24, 0xca, 0x6e, 0xdc,
+	0x80, 0x8d, 0x5f, 0xc2, 0xe8, 0x64, 0x31, 0x20, 0x16, 0x2c, 0x35, 0x7b, 0x7a, 0x5a, 0xd2, 0xca,
+	0x3f, 0x8a, 0xd8, 0x0f, 0xf2, 0xca, 0xf8, 0x40, 0x7d, 0x38, 0xa2, 0x76, 0x2d, 0x37, 0x53, 0xfe,
+	0x17, 0x77, 0xc7, 0x13, 0x8b, 0xc8, 0xf6, 0xd0, 0x38, 0xef, 0x2e, 0x6a, 0xbc, 0xd6, 0x44, 0xc2,
+	0xf6, 0x63, 0x3a, 0xf2, 0x07, 0x97, 0xd7, 0x91, 0x19, 0x6c, 0x82, 0x38, 0xa9, 0x18, 0xc5, 0x49,
+	0x2c, 0xea, 0x63, 0xf1, 0x4e, 0x2d, 0xca, 0x17, 0x94, 0x64, 0xd4, 0x97, 0x04, 0x93, 0x47, 0x50,
+	0x0e, 0x41, 0xc2, 0xe0, 0x78, 0xca, 0x7b, 0xfc, 0xb4, 0xbb, 0xe0, 0x18, 0x1e, 0x4d, 0x73, 0xa1,
+	0x8f, 0xa4, 0x73, 0x5a, 0x84, 0xde, 0x49, 0x28, 0x53, 0x33, 0x18, 0x6a, 0x57, 0xbc, 0xcf, 0x3d,
+	0x94, 0xc8, 0x07, 0x83, 0xd5, 0x4c, 0x88, 0x8c, 0x21, 0xc4, 0x04, 0x36, 0x5a, 0xb8, 0x6a, 0x69,
+	0xcb, 0x62, 0xca, 0x40, 0xdc, 0xab, 0x6d, 0xd1, 0x2d, 0xaf, 0x4e, 0x80, 0x4d, 0xd6, 0xa1, 0x84,
+	0xa3, 0x9f, 0x54, 0xcf, 0x1d, 0xdd, 0xf2, 0xd8, 0x45, 0x20, 0x69, 0xf2, 0x9d, 0x78, 0xb7, 0x24,
+	0x4f, 0xd2, 0x90, 0x45, 0x18, 0x67, 0x80, 0xad, 0x0d, 0xe5, 0xfb, 0x7c, 0x5d, 0xb2, 0x45, 0x36,
+	0xa0, 0xc8, 0x7e, 0xed, 0x52, 0xff, 0xcc, 0x76, 0xdf, 0x7a, 0xd2, 0x1c, 0x0e, 0x36, 0xb3, 0x09,
+	0x2a, 0xf2, 0x13, 0xe4, 0x82, 0x07, 0x67, 0xc8, 0x24, 0x85, 0xb4, 0x3c, 0xcb, 0xb1, 0x19, 0xc6,
+	0x7a, 0xe5, 0x04, 0x13, 0x14, 0x2c, 0x8f, 0x65, 0x09, 0x6e, 0xca, 0x77, 0xf8, 0x04, 0x83, 0x26,
+	0x79, 0x06, 0x8b, 0xe8, 0xd4, 0x6c, 0xec, 0xd6, 0xeb, 0x94, 0x29, 0x93, 0x58, 0x5e, 0xe6, 0x31,
+	0x3f, 0xcb, 0x1e, 0xbd, 0xe4, 0x17, 0xb0, 0x6c, 0x63, 0xe4, 0x54, 0x37, 0x9a, 0xb4, 0xa1, 0xbb,
+	0x5b, 0xd6, 0x1b, 0x7e, 0xdf, 0xc4, 0xe0, 0xb8, 0x20, 0xe5, 0xe1, 0xc0, 0xc3, 0xeb, 0x4b, 0x4f,
+	0x3e, 0x83, 0xa2, 0x6d, 0x45, 0xd9, 0x20, 0x69, 0x1b, 0xfb, 0xf1, 0x4b, 0xe0, 0x13, 0x0d, 0x16,
+	0x6d, 0x87, 0xc9, 0xb9, 0xed, 0xee, 0xe8, 0x16, 0x8a, 0xe3, 0x6b, 0x7a, 0x74, 0x62, 0xdb, 0x78,
+	0x06, 0x1f, 0x0c, 0xe4, 0xd4, 0x83, 0x12, 0x15, 0xed, 0xac, 0xe3, 0x1a, 0xb6, 0x8b, 0x8a, 0x6b,
+	0xdd, 0xd4, 0x3d, 0x8f, 0x07, 0xcf, 0xb7, 0xc2, 0x48, 0xbf, 0xbb, 0x93, 0xbb, 0x83, 0xae, 0x7d,
+	0x7e, 0xa1, 0x2c, 0xf3, 0x41, 0xe3, 0xee, 0x20, 0x03, 0x87, 0xee, 0x20, 0x6b, 0xa0, 0x08, 0x4f,
+	0xf1, 0x1f, 0x5b, 0xe8, 0x5a, 0x2a, 0xb7, 0xd3, 0xe9, 0xa5, 0x5a, 0xd0, 0x25, 0x89, 0x22, 0x5c,
+	0xf2, 0x1e, 0xe4, 0xbd, 0xa6, 0xa7, 0xdc, 0x49, 0x7b, 0x90, 0xf5, 0x8d, 0xba, 0x44, 0x66, 0xfd,
+	0x41, 0x0a, 0xe4, 0xee, 0x10, 0x29, 0x90, 0x15, 0x18, 0xf7, 0x5d, 0x6c, 0xb9, 0xca, 0x7d, 0x8e,
+	0x1d, 0xf3, 0x2d, 0xf7, 0x39, 0x3c, 0xc8, 0x71, 0x09, 0x2c, 0x96, 0x7d, 0xf2, 0x5d, 0x14, 0xb5,
+	0x0d, 0xbb, 0x8d, 0x0e, 0x83, 0xa2, 0x72, 0x19, 0x8b, 0x83, 0x30, 0x4a, 0x1a, 0xef, 0x78, 0x74,
+	0x67, 0xbd, 0xa6, 0xbc, 0x3b, 0x70, 0xff, 0x25, 0x26, 0xcb, 0x58, 0xb9, 0xb4, 0x6d, 0xfb, 0xb4,
+	0x66, 0x98, 0xb6, 0x5f, 0x69, 0x36, 0x99, 0xc1, 0x54, 0x3e, 0xe4, 0xcc, 0x33, 0x7a, 0xd8, 0xac,
+	0xb9, 0x3e, 0x69, 0x2a, 0xcf, 0xd2, 0xb3, 0xde, 0xe2, 0xf0, 0x60, 0xd6, 0x02, 0x8b, 0x25, 0x43,
+	0x1c, 0x46, 0xbf, 0x4e, 0x5d, 0x1f, 0xb7, 0xf7, 0x14, 0x65, 0xd1, 0x55, 0x9e, 0x8b, 0x64, 0x48,
+	0x57, 0x07, 0x4b, 0x00, 0xbd, 0x39, 0xf3, 0xa5, 0x4e, 0xfc, 0x44, 0x24, 0x49, 0x43, 0x00, 0x3f,
+	0x03, 0x54, 0x80, 0x2f, 0xba, 0xce, 0x60, 0x3f, 0x3a, 0x03, 0x54, 0x84, 0x4b, 0x2c, 0x0a, 0x3f,
+	0x35, 0xb8, 0xa2, 0xf9, 0xa1, 0xc8, 0x2d, 0x06, 0x6d, 0xb2, 0x06, 0xd3, 0x6d, 0xbb, 0x63, 0xf9,
+	0x3b, 0xbe, 0xe9, 0xb1, 0x91, 0x3d, 0xe5, 0x47, 0x03, 0xb7, 0x2a, 0x45, 0xc1, 0x33, 0xb9, 0x7a,
+	0xb0, 0x53, 0x9f, 0xca, 0x4c, 0x6e, 0x00, 0x60, 0x23, 0xd0, 0x73, 0x3c, 0x68, 0xf4, 0xed, 0xc4,
+	0x86, 0x28, 0x9f, 0x0d, 0x1e, 0x21, 0x49, 0x81, 0xca, 0xa8, 0xd4, 0xa0, 0xe8, 0xca, 0x85, 0x2c,
+	0x7e, 0x3c, 0x90, 0x45, 0x92, 0x40, 0xfd, 0x1e, 0x4c, 0x85, 0xbb, 0xc2, 0x24, 0x47, 0x86, 0x14,
+	0x2c, 0x40, 0x92, 0xd9, 0xf7, 0x38, 0x48, 0xd5, 0xa0, 0x18, 0x3f, 0x3d, 0xbe, 0x08, 0xee, 0x87,
+	0x55, 0x70, 0x52, 0x17, 0x9e, 0xe1, 0x0d, 0xe1, 0xb9, 0xa5, 0x28, 0xd4, 0xc7, 0x30, 0x97, 0x61,
+	0x14, 0x98, 0x2b, 0x6a, 0xf2, 0xb4, 0xaf, 0x70, 0x4f, 0x45, 0x43, 0xfd, 0x75, 0x19, 0xe6, 0xb3,
+	0x1c, 0xb9, 0xff, 0x57, 0xb9, 0x0f, 0x76, 0xac, 0x78, 0x5b, 0xed, 0x76, 0x5d, 0x6c, 0xbd, 0x74,
+	0xbd, 0xfa, 0x1f, 0x6b, 0x9c, 0x20, 0xee, 0x4a, 0xc3, 0xa5, 0xb3, 0x27, 0x85, 0xcb, 0x64, 0x4f,
+	0xd6, 0xc2, 0xec, 0xc9, 0x0c, 0x8f, 0x7c, 0x1f, 0xf5, 0x77, 0xb8, 0x33, 0xd3, 0x27, 0xe8, 0x91,
+	0x98, 0xb6, 0xde, 0x5c, 0xd3, 0x4d, 0xdd, 0x42, 0x0d, 0xb6, 0x55, 0xe3, 0x29, 0x73, 0xf4, 0x48,
+	0x92, 0x50, 0x96, 0xe4, 0x8c, 0x43, 0x44, 0x72, 0x5c, 0xd3, 0xad, 0x16, 0xcf, 0x9d, 0x33, 0x0b,
+	0xd9, 0xb3, 0x9f, 0x54, 0x81, 0x24, 0xdc, 0x04, 0x9e, 0x02, 0x40, 0xff, 0xa2, 0x4f, 0x66, 0x20,
+	0x83, 0x20, 0xcc, 0xf4, 0x7c, 0xb7, 0x4f, 0xa6, 0x67, 0xee, 0x1b, 0xcc, 0xf4, 0xcc, 0x7f, 0x8b,
+	0x99, 0x9e, 0x85, 0xff, 0x8d, 0x4c, 0xcf, 0xe2, 0xb7, 0x9a, 0xe9, 0xb9, 0x31, 0x44, 0xa6, 0xe7,
+	0x21, 0x14, 0x5d, 0xea, 0xe0, 0x88, 0xfa, 0x3a, 0xd3, 0xd7, 0x3c, 0x26, 0x2f, 0x89, 0xc3, 0x88,
+	0xc3, 0x51, 0xb2, 0x63, 0x19, 0xa1, 0x9b, 0x97, 0x38, 0x87, 0x7e, 0xe9, 0xa1, 0x5b, 0xd7, 0x4f,
+	0x0f, 0x2d, 0x7f, 0x03, 0xe9, 0xa1, 0xdb, 0xb1, 0xf4, 0xd0, 0x33, 0x99, 0x1e, 0x12, 0x2e, 0x8b,
+	0xda, 0xeb, 0xfe, 0x7e, 0x85, 0x38, 0x89, 0x4c, 0x51, 0x46, 0x6a, 0xe7, 0xee, 0xb7, 0x90, 0xda,
+	0xb9, 0x77, 0xdd, 0xd4, 0xce, 0x53, 0x58, 0x08, 0xcc, 0x26, 0xfa, 0x4b, 0x28, 0x43, 0x0d, 0xe9,
+	0x37, 0x08, 0xcf, 0x28, 0xbb, 0x33, 0x9d, 0x07, 0x7b, 0xf7, 0x9a, 0x79, 0xb0, 0x9f, 0x41, 0x51,
+	0xe6, 0x27, 0x84, 0xe2, 0x79, 0x70, 0xb9, 0x44, 0x50, 0x82, 0xb8, 0x67, 0x76, 0xe9, 0xbd, 0x6f,
+	0x22, 0xbb, 0xd4, 0x95, 0x09, 0x7b, 0x78, 0xad, 0x4c, 0x58, 0x22, 0x59, 0xf5, 0xbd, 0xff, 0xa1,
+	0x64, 0xd5, 0x09, 0x28, 0xbd, 0x84, 0xf7, 0x8a, 0x8f, 0x98, 0x18, 0x54, 0x7a, 0x1d, 0x14, 0x8f,
+	0x73, 0x39, 0x98, 0x6c, 0xa9, 0x7f, 0x0d, 0x73, 0x19, 0x21, 0xe9, 0x15, 0x07, 0x11, 0x7e, 0xf9,
+	0xd6, 0xf6, 0xda, 0x10, 0x5e, 0x94, 0xc4, 0x54, 0xff, 0x90, 0x03, 0xd2, 0x1d, 0x72, 0x5e, 0x71,
+	0x02, 0xe8, 0x00, 0xca, 0x37, 0x79, 0x1e, 0x4e, 0x89, 0xa5, 0xc6, 0x41, 0x2c, 0x0c, 0x68, 0x71,
+	0x67, 0x4d, 0x84, 0x12, 0x75, 0xb1, 0x27, 0x79, 0x11, 0x06, 0x74, 0xf7, 0x90, 0xcf, 0x81, 0x18,
+	0x16, 0x2f, 0x26, 0xa8, 0x5a, 0xa7, 0xf6, 0xc5, 0xa6, 0x61, 0xb2, 0xa0, 0x79, 0x74, 0xe0, 0x94,
+	0x32, 0xa8, 0xd4, 0xbf, 0xcd, 0xc1, 0xad, 0xbd, 0x8e, 0x7f, 0x84, 0xda, 0xb9, 0x99, 0xb8, 0xac,
+	0x72, 0xcd, 0x9f, 0xc1, 0x68, 0x1b, 0xad, 0x29, 0x9f, 0xf6, 0x74, 0xdc, 0x11, 0xe9, 0x43, 0xb4,
+	0xb2, 0x83, 0x14, 0x1a, 0xa7, 0x53, 0xdf, 0x87, 0x51, 0xd6, 0x22, 0x25, 0x98, 0xaa, 0x6c, 0x6f,
+	0xef, 0xbd, 0x3e, 0xac, 0xec, 0x7e, 0x59, 0x7e, 0x87, 0xcc, 0x42, 0x49, 0xab, 0xbe, 0xdc, 0xaa,
+	0xef, 0x6b, 0x5f, 0x1e, 0xee, 0xed, 0x6e, 0x7f, 0x59, 0xce, 0xa9, 0xff, 0x59, 0x84, 0x02, 0x8f,
+	0x76, 0xae, 0xb5, 0xdb, 0x59, 0x2e, 0xeb, 0xc8, 0x75, 0x5d, 0xd6, 0x1e, 0xee, 0x68, 0xda, 0xad,
+	0x1d, 0xcd, 0x70, 0x6b, 0xd3, 0x86, 0x71, 0xac, 0x87, 0x61, 0x0c, 0xdf, 0xe4, 0xc7, 0xe3, 0x6f,
+	0xf2, 0x0f, 0xa0, 0xc4, 0x03, 0xd0, 0xba, 0xde, 0x76, 0x98, 0x16, 0xe6, 0x8f, 0x70, 0x39, 0x2d,
+	0x09, 0x4c, 0x3e, 0xb3, 0x4c, 0x0d, 0xfd, 0xcc, 0xc2, 0xca, 0x5a, 0xf8, 0x56, 0x47, 0x49, 0x08,
+	0x90, 0x65, 0x2d, 0x49, 0x70, 0xe0, 0x77, 0x17, 0xae, 0xe2, 0x77, 0xa7, 0x1d, 0xb9, 0xe2, 0x95,
+	0x1d, 0xb9, 0x06, 0xdc, 0x7d, 0x4b, 0xa9, 0xa3, 0x9b, 0xc6, 0x29, 0xdb, 0x5a, 0xe6, 0x96, 0xf3,
+	0xab, 0x69, 0x61, 0x37, 0x0e, 0x5c, 0xc1, 0xcd, 0x0b, 0x6a, 0x56, 0xd2, 0x27, 0xbd, 0x21, 0x2b,
+	0xae, 0xb4, 0x41, 0x1c, 0xd0, 0x00, 0x97, 0x9b, 0x78, 0x2e, 0xf6, 0x45, 0x1b, 0x43, 0x3a, 0xa1,
+	0x2a, 0x65, 0x55, 0xcb, 0x60, 0xe7, 0xa0, 0x8b, 0x92, 0x29, 0xea, 0x46, 0x98, 0x31, 0x22, 0x83,
+	0x15, 0x75, 0x88, 0x1c, 0x4b, 0x27, 0xcc, 0x0f, 0x9d, 0x4e, 0x90, 0xa1, 0xc6, 0xc2, 0x65, 0x42,
+	0x8d, 0x0c, 0x87, 0x43, 0xf9, 0x16, 0x1c, 0x8e, 0x9b, 0xd7, 0x7f, 0x4b, 0x4a, 0xb8, 0x0e, 0x4b,
+	0xd7, 0x74, 0x1d, 0x4e, 0xe0, 0xbe, 0xd0, 0x18, 0x35, 0xb6, 0x9d, 0x0d, 0xdb, 0xac, 0x5b, 0x06,
+	0xf3, 0x84, 0xd9, 0x44, 0x02, 0xcd, 0x26, 0x9d, 0xc2, 0x7e, 0x3b, 0x3f, 0x98, 0x09, 0x39, 0x86,
+	0x7b, 0x3d, 0x91, 0xb6, 0x2c, 0x31, 0xd0, 0xed, 0x81, 0x03, 0x0d, 0xe4, 0x91, 0x11, 0xe6, 0xdc,
+	0xb9, 0x46, 0x98, 0xf3, 0x63, 0x28, 0x0a, 0x59, 0x14, 0xf1, 0x9e, 0x74, 0x42, 0x6f, 0xc5, 0x62,
+	0x80, 0x48, 0x53, 0xcb, 0x90, 0x30, 0x41, 0x80, 0x92, 0x7f, 0xe3, 0xcd, 0xd9, 0x5b, 0x8f, 0x29,
+	0x1f, 0x13, 0x2f, 0x59, 0xf5, 0x1c, 0x55, 0x16, 0xf3, 0x40, 0xd6, 0x2b, 0xdc, 0xf9, 0x9c, 0xd2,
+	0x7a, 0x75, 0x93, 0x8f, 0x61, 0xc2, 0x31, 0x3b, 0x2d, 0x03, 0x57, 0x70, 0x3f, 0x9d, 0x23, 0x0c,
+	0x4f, 0x59, 0xac, 0x41, 0x0b, 0x30, 0x83, 0x3c, 0xbf, 0xda, 0x55, 0x0f, 0xf5, 0xee, 0xe0, 0x64,
+	0xa0, 0xfa, 0x1b, 0x34, 0xf7, 0x7c, 0x3d, 0xd2, 0xbf, 0x91, 0x06, 0x88, 0xe5, 0xf4, 0x05, 0x20,
+	0x48, 0x19, 0xe4, 0x64, 0x4e, 0x3f, 0x01, 0x25, 0x07, 0xb0, 0x60, 0x84, 0x84, 0x3e, 0x13, 0x5f,
+	0xea, 0xee, 0x44, 0x36, 0x33, 0x56, 0xeb, 0x93, 0x89, 0xa6, 0x65, 0x53, 0x33, 0xeb, 0x12, 0x74,
+	0xb0, 0x74, 0xab, 0xf4, 0x07, 0x12, 0x30, 0x75, 0x0b, 0x66, 0xf9, 0xc4, 0x13, 0x26, 0xfb, 0x6a,
+	0x85, 0x35, 0x3e, 0xcc, 0xec, 0xa3, 0xa6, 0x6d, 0x53, 0xf4, 0x0b, 0xaf, 0x65, 0x81, 0x1f, 0xc3,
+	0xc8, 0xe9, 0xaa, 0x7c, 0x6d, 0x8b, 0x09, 0x4c, 0xc8, 0xfc, 0xd5, 0xaa, 0x8c, 0x78, 0x10, 0x4d,
+	0xfd, 0xfb, 0x3c, 0xcc, 0x76, 0xf5, 0x5c, 0x71, 0xe0, 0x2f, 0x60, 0x16, 0xd9, 0xe8, 0x4d, 0xdd,
+	0xd7, 0x0f, 0xe9, 0x79, 0xe3, 0x84, 0xe5, 0x28, 0xa4, 0x57, 0xf4, 0x38, 0x73, 0x1e, 0x3b, 0x12,
+	0xbb, 0x2a, 0x91, 0xe5, 0xbc, 0xca, 0xed, 0x14, 0x9c, 0x54, 0x01, 0x70, 0x60, 0x04, 0x9f, 0xd0,
+	0x4e, 0x90, 0x8d, 0x7b, 0x2f, 0x93, 0x65, 0x2d, 0x44, 0x93, 0xcc, 0x62, 0x84, 0x68, 0x0a, 0x0b,
+	0x9e, 0xaf, 0x37, 0xde, 0x36, 0x5d, 0xb4, 0x3f, 0xae, 0xdc, 0xa2, 0x87, 0x99, 0x7c, 0xea, 0x0c,
+	0x6f, 0x83, 0xe3, 0x49, 0x46, 0x71, 0x52, 0xf2, 0x17, 0x30, 0xab, 0x37, 0xd0, 0x86, 0x7b, 0x87,
+	0xa6, 0xdd, 0x3a, 0x74, 0xa2, 0xb2, 0xd7, 0xc2, 0xea, 0x87, 0x99, 0xfc, 0x2a, 0x1c, 0x7b, 0xdb,
+	0x6e, 0x09, 0x49, 0x11, 0xce, 0x9f, 0xe4, 0x3c, 0xa3, 0x27, 0x3b, 0x55, 0x1d, 0xee, 0x0f, 0xdc,
+	0x25, 0x8c, 0xc2, 0x0b, 0x67, 0xba, 0xd7, 0x1e, 0xde, 0xc7, 0x8a, 0xa3, 0xab, 0xff, 0x9a, 0x87,
+	0x5b, 0x7d, 0xb6, 0xed, 0x8a, 0x12, 0x70, 0xad, 0x39, 0x91, 0x9f, 0x07, 0xfe, 0xd0, 0xa1, 0x8d,
+	0x7b, 0xec, 0x1a, 0x78, 0x83, 0xc5, 0x11, 0x3d, 0x1d, 0xea, 0xa8, 0x57, 0xc4, 0x3f, 0x7b, 0x92,
+	0x56, 0x9b, 0x6e, 0x24, 0xda, 0x4b, 0xbf, 0xcf, 0xc1, 0x74, 0x12, 0x05, 0xfd, 0xaa, 0x89, 0xe4,
+	0x03, 0xff, 0x60, 0xa3, 0x1d, 0x10, 0xa0, 0x30, 0xa1, 0x1e, 0xe2, 0xaa, 0x5f, 0x3e, 0x31, 0xc9,
+	0xe5, 0x0e, 0x66, 0x91, 0xa2, 0xc3, 0x70, 0x62, 0xc6, 0x96, 0xd6, 0x2a, 0x60, 0x95, 0x1f, 0x92,
+	0x55, 0x9a, 0x50, 0xfd, 0x87, 0x31, 0x58, 0xee, 0x27, 0xc6, 0x57, 0x3c, 0xd8, 0xe7, 0xd1, 0xe3,
+	0xe7, 0xc0, 0x43, 0xe5, 0xf6, 0x2c, 0x7c, 0xfd, 0x7c, 0x01, 0xd0, 0xb6, 0x2d, 0x03, 0xfd, 0x47,
+	0x46, 0x3c, 0xb8, 0x06, 0x20, 0x86, 0x4d, 0x9e, 0xc1, 0xa4, 0x6f, 0xe3, 0xe5, 0xb2, 0x5b, 0x17,
+	0x43, 0x44, 0x57, 0x21, 0x2e, 0xd9, 0x80, 0x99, 0xa6, 0xe1, 0xb1, 0x99, 0x87, 0xae, 0xc4, 0xe0,
+	0x64, 0x73, 0x9a, 0x84, 0x1d, 0x70, 0x52, 0x82, 0xe4, 0x05, 0x1f, 0xe2, 0x80, 0x93, 0x74, 0xe4,
+	0x0d, 0x2c, 0x04, 0xe7, 0x14, 0xea, 0x01, 0xbe, 0x97, 0x13, 0xdc, 0x40, 0x3d, 0x1d, 0x4e, 0x03,
+	0xad, 0x24, 0x68, 0xb5, 0x6c, 0x96, 0xe8, 0x58, 0xcd, 0x4b, 0xf1, 0x4a, 0x0e, 0x35, 0x79, 0x8d,
+	0xa1, 0x32, 0x39, 0xaa, 0x4f, 0xa1, 0x94, 0x1c, 0x7a, 0x12, 0x46, 0x77, 0xf7, 0x76, 0xab, 0x18,
+	0x5d, 0xe2, 0xaf, 0xcd, 0x83, 0xed, 0xed, 0x72, 0x8e, 0xcc, 0x40, 0xa1, 0xaa, 0x69, 0x7b, 0x5a,
+	0x5d, 0x44, 0x99, 0x23, 0xea, 0x3f, 0xe6, 0xe0, 0xe1, 0x70, 0x7a, 0xf1, 0x8a, 0xa2, 0xfa, 0x12,
+	0x66, 0x51, 0x08, 0x5e, 0x1b, 0x56, 0xd3, 0x3e, 0x0b, 0xc2, 0x0e, 0x29, 0xb4, 0x7d, 0xe2, 0x92,
+	0x6e, 0x1a, 0xb5, 0x2a, 0x6d, 0x7b, 0xdc, 0xc9, 0x62, 0xa5, 0x30, 0x5e, 0xe7, 0xc8, 0x6b, 0xb8,
+	0xc6, 0x11, 0x6d, 0x46, 0x15, 0x18, 0x39, 0x9e, 0xa8, 0xcf, 0xea, 0x52, 0xff, 0x2e, 0x87, 0x61,
+	0x75, 0x94, 0xb0, 0x0d, 0x93, 0xed, 0xb9, 0x58, 0xb2, 0x1d, 0x61, 0x2c, 0x8d, 0xcb, 0xa7, 0x39,
+	0xa6, 0xf1, 0xdf, 0xec, 0x21, 0x8f, 0x45, 0x5f, 0xfc, 0xd1, 0x2a, 0xcf, 0xe1, 0x61, 0x9b, 0x15,
+	0x79, 0x8b, 0xc2, 0x67, 0xde, 0x3b, 0xca, 0x7b, 0x63, 0x10, 0x46, 0xeb, 0x48, 0x4f, 0x55, 0x7e,
+	0x8c, 0x11, 0xb6, 0xd5, 0x7f, 0x99, 0xc0, 0xf9, 0x44, 0x6f, 0xc3, 0x8c, 0x17, 0x0b, 0x98, 0xc5,
+	0x03, 0xb9, 0xac, 0x48, 0x8f, 0x41, 0x58, 0x08, 0x2c, 0x73, 0x25, 0xf2, 0xed, 0x55, 0x30, 0x4c,
+	0x02, 0xd9, 0x4b, 0x67, 0xc3, 0x6e, 0x3b, 0xb6, 0xc5, 0x62, 0xaf, 0xe0, 0xdb, 0x06, 0x11, 0x4a,
+	0x77, 0x77, 0x44, 0x2f, 0x6c, 0xeb, 0xb6, 0x4b, 0x37, 0x3a, 0x6d, 0x47, 0x46, 0xcd, 0x43, 0xbc,
+	0xb0, 0x05, 0x14, 0xec, 0x24, 0xe4, 0x17, 0x1d, 0xd2, 0x03, 0x17, 0x39, 0x48, 0x51, 0x69, 0x92,
+	0xd5, 0xc5, 0xe2, 0xed, 0x00, 0x5c, 0x93, 0x0f, 0x2c, 0xb2, 0xf2, 0x24, 0x05, 0x8e, 0x92, 0x01,
+	0xd3, 0xf1, 0x64, 0x00, 0xab, 0x5c, 0xb1, 0x92, 0xf4, 0x65, 0x59, 0xb9, 0x92, 0x04, 0x27, 0x3e,
+	0xf0, 0x20, 0xa9, 0x0f, 0x3c, 0x5e, 0x30, 0x5f, 0xc6, 0x38, 0x35, 0x4c, 0xda, 0x42, 0xc1, 0x9e,
+	0x1b, 0xac, 0x10, 0x23, 0x6c, 0xdc, 0xb7, 0x65, 0x97, 0xea, 0x4d, 0xc3, 0xc2, 0x3b, 0xc3, 0x1e,
+	0xe6, 0x0d, 0xdd, 0xdc, 0xa0, 0xa6, 0x7e, 0x51, 0xa7, 0xa8, 0x71, 0x9a, 0xe2, 0x61, 0xa5, 0xa4,
+	0xf5, 0xc5, 0x61, 0xf5, 0x18, 0x61, 0x7f, 0x8d, 0xba, 0x86, 0xdd, 0x0c, 0xa8, 0x17, 0x38, 0x75,
+	0x8f, 0x5e, 0xb4, 0xed, 0x37, 0xc3, 0x9e, 0x4d, 0x8c, 0x0a, 0x3b, 0x2e, 0xdd, 0x3f, 0x41, 0x4f,
+	0xf8, 0xc4, 0x36, 0x9b, 0xfc, 0x01, 0xa4, 0xa4, 0xf5, 0x46, 0x60, 0x52, 0x86, 0xfe, 0x93, 0xdf,
+	0xe1, 0xc9, 0x5e, 0x5e, 0x6b, 0x51, 0xd2, 0x62, 0x90, 0x64, 0x0a, 0x45, 0xb9, 0x44, 0x0a, 0x25,
+	0x28, 0x23, 0xb8, 0xc9, 0xf5, 0x5b, 0x39, 0xa2, 0x11, 0xf0, 0xb0, 0x80, 0x60, 0x15, 0xe6, 0xe5,
+	0x29, 0x07, 0x0a, 0x5e, 0xc8, 0xcb, 0x32, 0x3f, 0x9e, 0xcc, 0x3e, 0xf2, 0x19, 0x4c, 0x99, 0xc6,
+	0x31, 0x6d, 0x5c, 0x34, 0x30, 0x82, 0x7e, 0x30, 0xa4, 0xf2, 0x8f, 0x48, 0x48, 0x13, 0xee, 0xb2,
+	0xc5, 0x57, 0x1c, 0x9e, 0x67, 0x62, 0x4a, 0xe5, 0xc0, 0xf2, 0x0d, 0x93, 0xdf, 0x3e, 0xd4, 0xb9,
+	0xae, 0x1f, 0x64, 0xb7, 0xfb, 0x9d, 0xff, 0x20, 0x16, 0xea, 0x2f, 0x60, 0x26, 0x55, 0xba, 0x11,
+	0xc9, 0x6f, 0x2e, 0x2e, 0xbf, 0x89, 0x3d, 0x1e, 0x1b, 0x76, 0x8f, 0xd5, 0x75, 0xb8, 0xd1, 0xa3,
+	0x7a, 0x9f, 0x45, 0x7d, 0x2c, 0x2f, 0x25, 0xd3, 0xd7, 0x2c, 0xdb, 0xc4, 0xeb, 0x94, 0xda, 0xb6,
+	0x7b, 0x11, 0xa4, 0x94, 0x45, 0x4b, 0x7d, 0x09, 0x53, 0x61, 0xb1, 0x08, 0x5e, 0x81, 0x31, 0x9f,
+	0x7d, 0x39, 0x32, 0xac, 0x41, 0xe5, 0x33, 0x12, 0x24, 0xea, 0x5f, 0x42, 0x31, 0xfe, 0xba, 0xc4,
+	0xea, 0x11, 0x78, 0x85, 0x42, 0x4d, 0xf7, 0x4f, 0xe4, 0x44, 0x22, 0x40, 0xa8, 0x6c, 0x47, 0x62,
+	0xca, 0x96, 0x89, 0x22, 0xe7, 0xc0, 0xd3, 0xc1, 0x22, 0xaa, 0x8b, 0x41, 0xd4, 0x5f, 0xe5, 0xa0,
+	0x24, 0x43, 0xcb, 0xb0, 0x20, 0xa0, 0xa0, 0xc7, 0xe2, 0xfa, 0x61, 0x5d, 0xc5, 0x38, 0x11, 0x8b,
+	0x26, 0x83, 0x37, 0x99, 0x5a, 0xa0, 0xea, 0x4b, 0x5a, 0x02, 0x16, 0xce, 0x36, 0x9f, 0x34, 0x0d,
+	0xe9, 0xda, 0x67, 0xf5, 0xb7, 0xa3, 0xb0, 0x90, 0x59, 0xd7, 0x84, 0x21, 0xd8, 0x4d, 0xa1, 0x26,
+	0xa3, 0x42, 0xaa, 0xb5, 0x0b, 0x59, 0x0d, 0x38, 0x84, 0x3b, 0xde, 0x9b, 0x98, 0x7c, 0x09, 0x73,
+	0x16, 0xea, 0x2f, 0x39, 0x60, 0x98, 0x4d, 0x2c, 0x5c, 0xee, 0x1d, 0x25, 0x8b, 0x07, 0x7f, 0xf9,
+	0x31, 0x59, 0x09, 0x6e, 0x8a, 0x77, 0xf1, 0xb2, 0x2f, 0x3f, 0x19, 0x4c, 0xc8, 0x36, 0xcc, 0xb9,
+	0xf4, 0xcc, 0x35, 0x7c, 0x8a, 0x77, 0xe8, 0xa7, 0xfb, 0xfb, 0x35, 0xbc, 0x2b, 0x47, 0x94, 0x2b,
+	0xee, 0xfe, 0x7b, 0x91, 0x45, 0x46, 0x34, 0x98, 0x33, 0x38, 0x7f, 0x9a, 0xc8, 0xf4, 0x0c, 0x5b,
+	0x75, 0x97, 0x45, 0xcc, 0xfc, 0x4c, 0xfb, 0x28, 0xb1, 0xf0, 0x61, 0x13, 0x88, 0x29, 0x3a, 0x91,
+	0xb1, 0x78, 0x23, 0x72, 0xa9, 0x07, 0xda, 0x36, 0xd7, 0xca, 0x3c, 0x63, 0x11, 0xc1, 0xd4, 0xbf,
+	0x19, 0x81, 0x62, 0xbc, 0xc2, 0x8a, 0xd5, 0x35, 0xb2, 0xe8, 0xb2, 0x69, 0xb7, 0xba, 0x8b, 0x9c,
+	0x05, 0xe2, 0x86, 0xe8, 0x0e, 0xea, 0x1a, 0x25, 0x36, 0xf9, 0x94, 0x69, 0xc7, 0xd6, 0x89, 0x8f,
+	0x5e, 0x80, 0x23, 0x65, 0xeb, 0x6e, 0x9a, 0x74, 0x9b, 0x21, 0xd4, 0x11, 0x21, 0xa8, 0x29, 0x0b,
+	0x29, 0xd0, 0xbb, 0x1b, 0xff, 0xda, 0x70, 0xde, 0x1a, 0x41, 0x61, 0xf0, 0x72, 0x9a, 0xf6, 0x2b,
+	0xde, 0x1b, 0x54, 0x54, 0x09, 0x5c, 0xb2, 0x9e, 0x0c, 0xe1, 0x47, 0xd3, 0xdf, 0x19, 0x09, 0xd2,
+	0x7a, 0x84, 0x92, 0x11, 0xbd, 0xab, 0x4f, 0x60, 0x2e, 0x63, 0x65, 0xac, 0x86, 0x51, 0x97, 0x85,
+	0x4d, 0x42, 0x91, 0x04, 0x4d, 0xb5, 0x0e, 0x0b, 0x99, 0xeb, 0xe9, 0x4d, 0xc2, 0x5e, 0x9d, 0x44,
+	0x58, 0xbf, 0xcf, 0x35, 0x9d, 0x7c, 0x75, 0x8a, 0x81, 0xd4, 0x15, 0x20, 0xdd, 0x0b, 0xed, 0x33,
+	0x89, 0xff, 0xc8, 0xc1, 0x8d, 0x1e, 0xcb, 0x43, 0x67, 0x68, 0xac, 0x49, 0x8f, 0x3a, 0xad, 0x21,
+	0x1c, 0x65, 0x81, 0xc8, 0x1e, 0x90, 0xdb, 0xfa, 0xf9, 0x6e, 0xa7, 0x7d, 0x44, 0xdd, 0xbd, 0xe3,
+	0x8a, 0x8f, 0xa2, 0x75, 0xd4, 0xf1, 0xa9, 0x27, 0x15, 0x53, 0x76, 0x27, 0x73, 0x1e, 0xe2, 0x1d,
+	0xb1, 0x2b, 0x20, 0xde, 0x67, 0x7a, 0xf4, 0xb2, 0x22, 0x97, 0x58, 0xcf, 0x0e, 0x2e, 0x07, 0x0d,
+	0x92, 0xfc, 0x92, 0x51, 0xbc, 0xda, 0xf4, 0xec, 0x57, 0x7f, 0x97, 0x03, 0x58, 0xd3, 0xbd, 0x40,
+	0x19, 0x7f, 0x0e, 0x44, 0x7a, 0x82, 0xda, 0xc6, 0x3e, 0x6d, 0x3b, 0xa6, 0xee, 0x53, 0x6f, 0x88,
+	0x75, 0x67, 0x50, 0x31, 0xdf, 0xf6, 0x34, 0x2c, 0x36, 0x67, 0x37, 0x46, 0x1c, 0x53, 0x12, 0x48,
+	0x6a, 0xb0, 0x20, 0x68, 0x79, 0x95, 0x98, 0x98, 0x06, 0xb2, 0xf1, 0x86, 0x88, 0x66, 0xb3, 0x09,
+	0xd5, 0xe7, 0x40, 0x44, 0xc5, 0x99, 0xc6, 0x6b, 0x0c, 0xe5, 0xca, 0xd2, 0xd7, 0x37, 0x97, 0x71,
+	0x7d, 0xff, 0x69, 0x0c, 0xc6, 0x39, 0x6b, 0x8f, 0x15, 0x04, 0x36, 0x2c, 0x43, 0xde, 0xbc, 0xb9,
+	0xc4, 0xe7, 0xbb, 0x41, 0x41, 0x20, 0xf6, 0xe3, 0x41, 0x4f, 0xca, 0x94, 0x45, 0x60, 0xf4, 0x63,
+	0x9f, 0xfa, 0x26, 0x3f, 0x7e, 0xd0, 0x42, 0x4c, 0x56, 0xe9, 0x28, 0x1e, 0x3e, 0x65, 0xe4, 0xbc,
+	0x98, 0x2e, 0x76, 0x0e, 0xee, 0xa5, 0xc0, 0xe2, 0xc5, 0x2a, 0x2c, 0x58, 0x92, 0x95, 0x59, 0x0b,
+	0x99, 0x89, 0x6a, 0x4d, 0xe0, 0xb0, 0x3a, 0x54, 0x3f, 0x08, 0x01, 0x65, 0xdd, 0xed, 0xcd, 0x8c,
+	0xc0, 0x34, 0xd0, 0x19, 0x21, 0x2e, 0x79, 0x0d, 0x8b, 0x5e, 0xd2, 0xee, 0xc9, 0xd2, 0x59, 0xf9,
+	0xf0, 0x14, 0xd3, 0x3f, 0x99, 0xf6, 0x51, 0xeb, 0x41, 0xce, 0xbf, 0x57, 0x90, 0x1f, 0x68, 0x87,
+	0x1e, 0xd2, 0xec, 0x10, 0xdf, 0x2b, 0xa4, 0x68, 0xf0, 0x1e, 0x4e, 0x89, 0xef, 0x36, 0xd8, 0xc9,
+	0xcc, 0xf5, 0x3e, 0x99, 0x49, 0x8e, 0xb5, 0x8e, 0xc7, 0x13, 0xaf, 0xd7, 0x5c, 0x48, 0xd5, 0x6b,
+	0xa2, 0x6f, 0x63, 0x9f, 0x05, 0xdf, 0xd0, 0x0a, 0x65, 0x1e, 0x01, 0x70, 0x17, 0x81, 0xd5, 0x72,
+	0x09, 0x8e, 0xd2, 0x3d, 0xed, 0x99, 0x67, 0x8f, 0xa1, 0xb2, 0x0f, 0x3f, 0x8e, 0xf0, 0x3e, 0x49,
+	0xdf, 0x33, 0xf6, 0xe1, 0x47, 0x74, 0xcb, 0x34, 0x8e, 0xc1, 0xea, 0xc2, 0x8d, 0x98, 0x9c, 0xca,
+	0xaa, 0x89, 0xe5, 0x74, 0xd5, 0x6b, 0x5c, 0x8a, 0xb5, 0x04, 0x85, 0xaa, 0xc0, 0x62, 0xb6, 0xf1,
+	0x52, 0xef, 0xc2, 0xed, 0xbe, 0xf6, 0x5c, 0x5d, 0x84, 0xf9, 0xac, 0x47, 0x2a, 0x75, 0x16, 0x66,
+	0x52, 0xcf, 0x10, 0xea, 0xcf, 0xa1, 0x94, 0xf8, 0xec, 0xeb, 0x1b, 0xae, 0x87, 0x98, 0x81, 0x52,
+	0x62, 0x37, 0x1f, 0x7d, 0xde, 0xe3, 0xc5, 0x81, 0xa5, 0x3b, 0x0e, 0x76, 0xeb, 0xb5, 0xea, 0xfa,
+	0xd6, 0xe6, 0x56, 0x75, 0xa3, 0xfc, 0x0e, 0x29, 0xc0, 0xc4, 0x46, 0x75, 0xb3, 0x72, 0xb0, 0xbd,
+	0x5f, 0xce, 0x11, 0x80, 0xf1, 0xfa, 0xbe, 0xb6, 0xb5, 0xbe, 0x5f, 0x1e, 0x21, 0x13, 0x90, 0xdf,
+	0xdb, 0xdc, 0x2c, 0xe7, 0x1f, 0xbd, 0x0a, 0x42, 0x18, 0xd6, 0x2d, 0xac, 0x1c, 0xd2, 0x95, 0x62,
+	0xc6, 0x15, 0x29, 0x0b, 0xa1, 0x91, 0x46, 0xd2, 0x99, 0x84, 0x0d, 0x2c, 0xe7, 0xc9, 0x1c, 0xcc,
+	0xd8, 0x0e, 0xb5, 0xd6, 0xa9, 0xe5, 0x75, 0xbc, 0x4a, 0x0b, 0xb5, 0x66, 0x79, 0x74, 0x6d, 0xf1,
+	0x9f, 0x7f, 0x7f, 0xe7, 0x9d, 0xdf, 0xe1, 0xdf, 0xbf, 0xe1, 0xdf, 0x57, 0xe1, 0xff, 0x86, 0x71,
+	0x34, 0xce, 0x77, 0xe0, 0xe3, 0xff, 0x06, 0xd5, 0x68, 0xac, 0x6e, 0x4c, 0x43, 0x00, 0x00,
 }
@@ -15,6 +15,11 @@
 package cmd
 
 import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"istio.io/istio/tools/istio-iptables/pkg/builder"
 	"istio.io/istio/tools/istio-iptables/pkg/constants"
 	dep "istio.io/istio/tools/istio-iptables/pkg/dependencies"
 )
@@ -31,13 +36,14 @@ func removeOldChains(ext dep.Dependencies, cmd string) {
 		// Remove the old chains
 		ext.RunQuietlyAndIgnore(cmd, "-t", table, "-D", constants.PREROUTING, "-p", constants.TCP, "-j", constants.ISTIOINBOUND)
 	}
+	ext.RunQuietlyAndIgnore(cmd, "-t", constants.MANGLE, "-D", constants.PREROUTING, "-j", constants.ISTIOHARDENING)
 	ext.RunQuietlyAndIgnore(cmd, "-t", constants.NAT, "-D", constants.OUTPUT, "-p", constants.TCP, "-j", constants.ISTIOOUTPUT)
 
 	// Flush and delete the istio chains from NAT table.
 	chains := []string{constants.ISTIOOUTPUT, constants.ISTIOINBOUND}
 	flushAndDeleteChains(ext, cmd, constants.NAT, chains)
 	// Flush and delete the istio chains from MANGLE table.
-	chains = []string{constants.ISTIOINBOUND, constants.ISTIODIVERT, constants.ISTIOTPROXY}
+	chains = []string{constants.ISTIOINBOUND, constants.ISTIODIVERT, constants.ISTIOTPROXY, constants.ISTIOHARDENING}
 	flushAndDeleteChains(ext, cmd, constants.MANGLE, chains)
 
 	// Must be last, the others refer to it
@@ -45,14 +51,133 @@ func removeOldChains(ext dep.Dependencies, cmd string) {
 	flushAndDeleteChains(ext, cmd, constants.NAT, chains)
 }
 
-func cleanup(dryRun bool) {
-	var ext dep.Dependencies
-	if dryRun {
-		ext = &dep.StdoutStubDependencies{}
-	} else {
-		ext = &dep.RealDependencies{}
+// splitSaveLine splits a line of iptables-save output into arguments, unquoting the double-quoted
+// values iptables-save emits for strings such as comments.
+func splitSaveLine(line string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	inArg, inQuotes, escaped := false, false, false
+	for _, c := range line {
+		switch {
+		case escaped:
+			current.WriteRune(c)
+			escaped = false
+		case inQuotes && c == '\\':
+			escaped = true
+		case c == '"':
+			inQuotes = !inQuotes
+			inArg = true
+		case !inQuotes && (c == ' ' || c == '\t'):
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(c)
+			inArg = true
+		}
+	}
+	if inQuotes || escaped {
+		return nil, fmt.Errorf("unterminated quote in line %q", line)
 	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+// taggedRules parses iptables-save output and returns the arguments needed to delete every rule
+// tagged with an ownership comment for which owns returns true.
+func taggedRules(save string, owns func(builder.Owner) bool) ([][]string, error) {
+	var deletes [][]string
+	table := ""
+	scanner := bufio.NewScanner(strings.NewReader(save))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "*") {
+			table = strings.TrimPrefix(line, "*")
+			continue
+		}
+		if !strings.HasPrefix(line, "-A ") {
+			continue
+		}
+		args, err := splitSaveLine(line)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < len(args)-1; i++ {
+			if args[i] != "--comment" {
+				continue
+			}
+			if tagged, ok := builder.ParseOwnerComment(args[i+1]); ok && owns(tagged) {
+				args[0] = "-D"
+				deletes = append(deletes, append([]string{"-t", table}, args...))
+			}
+			break
+		}
+	}
+	return deletes, scanner.Err()
+}
+
+// ownedRules returns the arguments needed to delete every rule of the iptables-save output which
+// belongs to owner.
+func ownedRules(save string, owner builder.Owner) ([][]string, error) {
+	return taggedRules(save, owner.Owns)
+}
+
+// anyOwner selects the tagged rules of every owner
+func anyOwner(builder.Owner) bool {
+	return true
+}
+
+// removeTaggedRules deletes the rules listed by saveCmd for which owns returns true.
+func removeTaggedRules(ext dep.Dependencies, cmd string, saveCmd string, owns func(builder.Owner) bool) error {
+	save, err := ext.RunWithOutput(saveCmd)
+	if err != nil {
+		return fmt.Errorf("failed to list rules with %s: %v", saveCmd, err)
+	}
+	deletes, err := taggedRules(string(save), owns)
+	if err != nil {
+		return err
+	}
+	for _, args := range deletes {
+		ext.RunQuietlyAndIgnore(cmd, args...)
+	}
+	return nil
+}
+
+// removeOwnedRules removes only the rules tagged as owned by owner, then deletes the istio chains
+// that are left empty. Chains still holding rules of other owners are kept.
+func removeOwnedRules(ext dep.Dependencies, cmd string, saveCmd string, owner builder.Owner) error {
+	if err := removeTaggedRules(ext, cmd, saveCmd, owner.Owns); err != nil {
+		return err
+	}
+
+	// Deleting a chain fails if it still has rules or is referenced, which is exactly what we want.
+	for _, table := range []string{constants.NAT, constants.MANGLE} {
+		for _, chain := range []string{constants.ISTIOOUTPUT, constants.ISTIOINBOUND, constants.ISTIODIVERT, constants.ISTIOTPROXY,
+			constants.ISTIOHARDENING} {
+			ext.RunQuietlyAndIgnore(cmd, "-t", table, "-X", chain)
+		}
+	}
+	// Must be last, the others refer to it
+	for _, chain := range []string{constants.ISTIOREDIRECT, constants.ISTIOINREDIRECT} {
+		ext.RunQuietlyAndIgnore(cmd, "-t", constants.NAT, "-X", chain)
+	}
+	return nil
+}
+
+func cleanupOwned(ext dep.Dependencies, owner builder.Owner) error {
+	if err := removeOwnedRules(ext, constants.IPTABLES, constants.IPTABLESSAVE, owner); err != nil {
+		return err
+	}
+	// ip6tables is best effort, as it may not be available on IPv4 only hosts
+	_ = removeOwnedRules(ext, constants.IP6TABLES, constants.IP6TABLESSAVE, owner)
+	return nil
+}
 
+func cleanup(ext dep.Dependencies) {
 	defer func() {
 		for _, cmd := range []string{constants.IPTABLESSAVE, constants.IP6TABLESSAVE} {
 			// iptables-save is best efforts
@@ -60,6 +185,10 @@ func cleanup(dryRun bool) {
 		}
 	}()
 
+	// A tagged rule is only matched by a deletion carrying the same comment, so the tagged rules of
+	// every owner are looked up first. This is best effort, as iptables-save may not be available.
+	_ = removeTaggedRules(ext, constants.IPTABLES, constants.IPTABLESSAVE, anyOwner)
+	_ = removeTaggedRules(ext, constants.IP6TABLES, constants.IP6TABLESSAVE, anyOwner)
 	for _, cmd := range []string{constants.IPTABLES, constants.IP6TABLES} {
 		removeOldChains(ext, cmd)
 	}
@@ -0,0 +1,309 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"istio.io/istio/tools/istio-iptables/pkg/builder"
+)
+
+const iptablesSave = `# Generated by iptables-save v1.8.4 on Thu Jan  1 00:00:00 1970
+*nat
+:PREROUTING ACCEPT [0:0]
+:ISTIO_INBOUND - [0:0]
+:ISTIO_OUTPUT - [0:0]
+-A PREROUTING -p tcp -m comment --comment "owner=istio-cni,revision=canary,generation=1" -j ISTIO_INBOUND
+-A PREROUTING -p tcp -m comment --comment "owner=istio-cni,revision=stable,generation=1" -j ISTIO_INBOUND
+-A ISTIO_OUTPUT -m comment --comment "kubernetes service portals" -j RETURN
+-A ISTIO_OUTPUT -o lo -s 127.0.0.6/32 -j RETURN
+COMMIT
+*mangle
+-A ISTIO_INBOUND -p tcp -m comment --comment "owner=istio-cni,revision=canary,generation=1" -j ISTIO_TPROXY
+COMMIT
+`
+
+func TestOwnedRules(t *testing.T) {
+	actual, err := ownedRules(iptablesSave, builder.Owner{Component: "istio-cni", Revision: "canary"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]string{
+		{"-t", "nat", "-D", "PREROUTING", "-p", "tcp", "-m", "comment", "--comment",
+			"owner=istio-cni,revision=canary,generation=1", "-j", "ISTIO_INBOUND"},
+		{"-t", "mangle", "-D", "ISTIO_INBOUND", "-p", "tcp", "-m", "comment", "--comment",
+			"owner=istio-cni,revision=canary,generation=1", "-j", "ISTIO_TPROXY"},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Output mismatch\nExpected: %#v\nActual: %#v", expected, actual)
+	}
+
+	actual, err = ownedRules(iptablesSave, builder.Owner{Component: "istio-cni"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actual) != 3 {
+		t.Errorf("Expected all 3 istio-cni rules to match an empty revision; got %#v", actual)
+	}
+}
+
+func TestSplitSaveLine(t *testing.T) {
+	actual, err := splitSaveLine(`-A OUTPUT -m comment --comment "a \"quoted\" value" -j RETURN`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"-A", "OUTPUT", "-m", "comment", "--comment", `a "quoted" value`, "-j", "RETURN"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Output mismatch\nExpected: %#v\nActual: %#v", expected, actual)
+	}
+	if _, err := splitSaveLine(`-A OUTPUT --comment "unterminated`); err == nil {
+		t.Errorf("Expected an error for an unterminated quote")
+	}
+}
+
+// fakeIptables is a Dependencies implementation keeping the rules programmed through cmd in memory,
+// so that tests can check what a cleanup leaves behind. Commands for other binaries are ignored.
+type fakeIptables struct {
+	cmd    string
+	chains map[string][]string
+}
+
+func newFakeIptables(cmd string) *fakeIptables {
+	f := &fakeIptables{cmd: cmd, chains: map[string][]string{}}
+	for _, table := range []string{"nat", "mangle"} {
+		for _, chain := range []string{"PREROUTING", "INPUT", "OUTPUT", "POSTROUTING"} {
+			f.chains[table+" "+chain] = []string{}
+		}
+	}
+	return f
+}
+
+func (f *fakeIptables) referenced(table string, chain string) bool {
+	for key, rules := range f.chains {
+		if !strings.HasPrefix(key, table+" ") {
+			continue
+		}
+		for _, rule := range rules {
+			if strings.HasSuffix(rule, "-j "+chain) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (f *fakeIptables) Run(cmd string, args ...string) error {
+	if cmd != f.cmd {
+		return nil
+	}
+	if len(args) < 4 || args[0] != "-t" {
+		return fmt.Errorf("unsupported command %s %v", cmd, args)
+	}
+	key := args[1] + " " + args[3]
+	rule := strings.Join(args[4:], " ")
+	rules, exists := f.chains[key]
+	switch args[2] {
+	case "-N":
+		if exists {
+			return fmt.Errorf("chain %s already exists", key)
+		}
+		f.chains[key] = []string{}
+	case "-A":
+		if !exists {
+			return fmt.Errorf("no chain %s", key)
+		}
+		f.chains[key] = append(rules, rule)
+	case "-D":
+		for i, r := range rules {
+			if r == rule {
+				f.chains[key] = append(rules[:i:i], rules[i+1:]...)
+				return nil
+			}
+		}
+		return fmt.Errorf("no rule %q in %s", rule, key)
+	case "-F":
+		if !exists {
+			return fmt.Errorf("no chain %s", key)
+		}
+		f.chains[key] = []string{}
+	case "-X":
+		if !exists || len(rules) > 0 || f.referenced(args[1], args[3]) {
+			return fmt.Errorf("cannot delete chain %s", key)
+		}
+		delete(f.chains, key)
+	default:
+		return fmt.Errorf("unsupported command %s %v", cmd, args)
+	}
+	return nil
+}
+
+func (f *fakeIptables) RunOrFail(cmd string, args ...string) {
+	if err := f.Run(cmd, args...); err != nil {
+		panic(err)
+	}
+}
+
+func (f *fakeIptables) RunQuietlyAndIgnore(cmd string, args ...string) {
+	_ = f.Run(cmd, args...)
+}
+
+// RunWithOutput renders the rules in the iptables-save format
+func (f *fakeIptables) RunWithOutput(cmd string, args ...string) ([]byte, error) {
+	if cmd != f.cmd+"-save" {
+		return nil, fmt.Errorf("unsupported command %s %v", cmd, args)
+	}
+	keys := make([]string, 0, len(f.chains))
+	for key := range f.chains {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	table := ""
+	for _, key := range keys {
+		parts := strings.SplitN(key, " ", 2)
+		if parts[0] != table {
+			if table != "" {
+				fmt.Fprintln(&b, "COMMIT")
+			}
+			table = parts[0]
+			fmt.Fprintf(&b, "*%s\n", table)
+		}
+		for _, rule := range f.chains[key] {
+			args := strings.Split(rule, " ")
+			for i := 1; i < len(args); i++ {
+				if args[i-1] == "--comment" {
+					args[i] = strconv.Quote(args[i])
+				}
+			}
+			fmt.Fprintf(&b, "-A %s %s\n", parts[1], strings.Join(args, " "))
+		}
+	}
+	if table != "" {
+		fmt.Fprintln(&b, "COMMIT")
+	}
+	return []byte(b.String()), nil
+}
+
+func TestCleanupRemovesHardeningRules(t *testing.T) {
+	ext := newFakeIptables("iptables")
+	// Rules programmed by istio-iptables --drop-invalid --drop-martian-sources
+	for _, rule := range [][]string{
+		{"-t", "mangle", "-N", "ISTIO_HARDENING"},
+		{"-t", "mangle", "-A", "PREROUTING", "-j", "ISTIO_HARDENING"},
+		{"-t", "mangle", "-A", "ISTIO_HARDENING", "-m", "conntrack", "--ctstate", "INVALID", "-j", "DROP"},
+		{"-t", "mangle", "-A", "ISTIO_HARDENING", "!", "-i", "lo", "-s", "127.0.0.0/8", "-j", "DROP"},
+	} {
+		ext.RunOrFail("iptables", rule...)
+	}
+
+	cleanup(ext)
+
+	for key, rules := range ext.chains {
+		if strings.HasSuffix(key, " ISTIO_HARDENING") {
+			t.Errorf("Expected chain %s to be deleted", key)
+		}
+		if len(rules) > 0 {
+			t.Errorf("Expected no rule to be left in %s; got %#v", key, rules)
+		}
+	}
+}
+
+func TestCleanupRemovesTaggedRules(t *testing.T) {
+	ext := newFakeIptables("iptables")
+	comment := builder.Owner{Component: "istio-iptables", Generation: builder.RuleGeneration}.Comment()
+	// Rules programmed by istio-iptables with the default owner
+	for _, rule := range [][]string{
+		{"-t", "nat", "-N", "ISTIO_INBOUND"},
+		{"-t", "nat", "-N", "ISTIO_OUTPUT"},
+		{"-t", "nat", "-N", "ISTIO_REDIRECT"},
+		{"-t", "nat", "-A", "ISTIO_REDIRECT", "-m", "comment", "--comment", comment, "-p", "tcp", "-j", "REDIRECT", "--to-ports", "15001"},
+		{"-t", "nat", "-A", "PREROUTING", "-m", "comment", "--comment", comment, "-p", "tcp", "-j", "ISTIO_INBOUND"},
+		{"-t", "nat", "-A", "OUTPUT", "-m", "comment", "--comment", comment, "-p", "tcp", "-j", "ISTIO_OUTPUT"},
+		{"-t", "nat", "-A", "ISTIO_OUTPUT", "-m", "comment", "--comment", comment, "-j", "ISTIO_REDIRECT"},
+	} {
+		ext.RunOrFail("iptables", rule...)
+	}
+
+	cleanup(ext)
+
+	for key, rules := range ext.chains {
+		if strings.Contains(key, " ISTIO_") {
+			t.Errorf("Expected chain %s to be deleted", key)
+		}
+		if len(rules) > 0 {
+			t.Errorf("Expected no rule to be left in %s; got %#v", key, rules)
+		}
+	}
+}
+
+// recordingDependencies returns save as the output of iptables-save and records every command
+type recordingDependencies struct {
+	save     string
+	executed []string
+}
+
+func (r *recordingDependencies) record(cmd string, args ...string) {
+	r.executed = append(r.executed, strings.Join(append([]string{cmd}, args...), " "))
+}
+
+func (r *recordingDependencies) RunOrFail(cmd string, args ...string) {
+	r.record(cmd, args...)
+}
+
+func (r *recordingDependencies) Run(cmd string, args ...string) error {
+	r.record(cmd, args...)
+	return nil
+}
+
+func (r *recordingDependencies) RunQuietlyAndIgnore(cmd string, args ...string) {
+	r.record(cmd, args...)
+}
+
+func (r *recordingDependencies) RunWithOutput(cmd string, args ...string) ([]byte, error) {
+	r.record(cmd, args...)
+	return []byte(r.save), nil
+}
+
+func TestRemoveOwnedRules(t *testing.T) {
+	ext := &recordingDependencies{save: iptablesSave}
+	if err := removeOwnedRules(ext, "iptables", "iptables-save", builder.Owner{Component: "istio-cni", Revision: "canary"}); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"iptables-save",
+		"iptables -t nat -D PREROUTING -p tcp -m comment --comment owner=istio-cni,revision=canary,generation=1 -j ISTIO_INBOUND",
+		"iptables -t mangle -D ISTIO_INBOUND -p tcp -m comment --comment owner=istio-cni,revision=canary,generation=1 -j ISTIO_TPROXY",
+		"iptables -t nat -X ISTIO_OUTPUT",
+		"iptables -t nat -X ISTIO_INBOUND",
+		"iptables -t nat -X ISTIO_DIVERT",
+		"iptables -t nat -X ISTIO_TPROXY",
+		"iptables -t nat -X ISTIO_HARDENING",
+		"iptables -t mangle -X ISTIO_OUTPUT",
+		"iptables -t mangle -X ISTIO_INBOUND",
+		"iptables -t mangle -X ISTIO_DIVERT",
+		"iptables -t mangle -X ISTIO_TPROXY",
+		"iptables -t mangle -X ISTIO_HARDENING",
+		"iptables -t nat -X ISTIO_REDIRECT",
+		"iptables -t nat -X ISTIO_IN_REDIRECT",
+	}
+	if !reflect.DeepEqual(ext.executed, expected) {
+		t.Errorf("Output mismatch\nExpected: %#v\nActual: %#v", expected, ext.executed)
+	}
+}
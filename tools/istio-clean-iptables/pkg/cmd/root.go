@@ -21,30 +21,89 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"istio.io/istio/tools/istio-iptables/pkg/builder"
 	"istio.io/istio/tools/istio-iptables/pkg/constants"
+	dep "istio.io/istio/tools/istio-iptables/pkg/dependencies"
 	"istio.io/pkg/log"
 )
 
+// settings holds the flags of this command. pilot-agent registers both istio-iptables and
+// istio-clean-iptables, and they bind the same flag names with different defaults, so this
+// command does not share the global viper keys with istio-iptables.
+var settings = viper.New()
+
 var rootCmd = &cobra.Command{
 	Use:   "istio-clean-iptables",
 	Short: "Clean up iptables rules for Istio Sidecar",
 	Long:  "Script responsible for cleaning up iptables rules",
 	Run: func(cmd *cobra.Command, args []string) {
-		cleanup(viper.GetBool(constants.DryRun))
+		variant, err := dep.ResolveIptablesVariant(settings.GetString(constants.IptablesVariant))
+		if err != nil {
+			handleError(err)
+		}
+		var ext dep.Dependencies = &dep.RealDependencies{IptablesVariant: variant}
+		if settings.GetBool(constants.DryRun) {
+			ext = &dryRunDependencies{save: ext}
+		}
+
+		if component := settings.GetString(constants.OwnerComponent); component != "" {
+			owner := builder.Owner{Component: component, Revision: settings.GetString(constants.OwnerRevision)}
+			if err := owner.Validate(); err != nil {
+				handleError(err)
+			}
+			if err := cleanupOwned(ext, owner); err != nil {
+				handleError(err)
+			}
+			return
+		}
+		cleanup(ext)
 	},
 }
 
 func init() {
 	// Read in all environment variables
-	viper.AutomaticEnv()
+	settings.AutomaticEnv()
 	// Replace - with _; so that environment variables are looked up correctly.
-	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+	settings.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
 
 	rootCmd.Flags().BoolP(constants.DryRun, "n", false, "Do not call any external dependencies like iptables")
-	if err := viper.BindPFlag(constants.DryRun, rootCmd.Flags().Lookup(constants.DryRun)); err != nil {
+	if err := settings.BindPFlag(constants.DryRun, rootCmd.Flags().Lookup(constants.DryRun)); err != nil {
+		handleError(err)
+	}
+	settings.SetDefault(constants.DryRun, false)
+
+	rootCmd.Flags().String(constants.OwnerComponent, "",
+		"Only remove the rules tagged as owned by this component, instead of all istio chains")
+	if err := settings.BindPFlag(constants.OwnerComponent, rootCmd.Flags().Lookup(constants.OwnerComponent)); err != nil {
+		handleError(err)
+	}
+	settings.SetDefault(constants.OwnerComponent, "")
+
+	rootCmd.Flags().String(constants.OwnerRevision, "",
+		"Only remove the rules owned by this revision of --"+constants.OwnerComponent+". An empty value matches all revisions")
+	if err := settings.BindPFlag(constants.OwnerRevision, rootCmd.Flags().Lookup(constants.OwnerRevision)); err != nil {
 		handleError(err)
 	}
-	viper.SetDefault(constants.DryRun, false)
+	settings.SetDefault(constants.OwnerRevision, "")
+
+	rootCmd.Flags().String(constants.IptablesVariant, "",
+		"The iptables variant to use, either \""+constants.IptablesVariantLegacy+"\", \""+constants.IptablesVariantNft+
+			"\" or \""+constants.IptablesVariantAuto+"\" to detect the variant already holding rules. If unset, the default iptables binaries are used")
+	if err := settings.BindPFlag(constants.IptablesVariant, rootCmd.Flags().Lookup(constants.IptablesVariant)); err != nil {
+		handleError(err)
+	}
+	settings.SetDefault(constants.IptablesVariant, "")
+}
+
+// dryRunDependencies only prints the commands changing the rules. The current rules are still read
+// with the iptables-save of the resolved variant, so that the owned rules to delete can be printed.
+type dryRunDependencies struct {
+	dep.StdoutStubDependencies
+	save dep.Dependencies
+}
+
+func (d *dryRunDependencies) RunWithOutput(cmd string, args ...string) ([]byte, error) {
+	return d.save.RunWithOutput(cmd, args...)
 }
 
 func GetCommand() *cobra.Command {
@@ -0,0 +1,84 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"istio.io/istio/tools/istio-iptables/pkg/builder"
+	iptables "istio.io/istio/tools/istio-iptables/pkg/cmd"
+	"istio.io/istio/tools/istio-iptables/pkg/constants"
+)
+
+// pilot-agent registers both commands, which bind the same flag names with different defaults.
+func TestFlagsNotSharedWithIstioIptables(t *testing.T) {
+	agent := &cobra.Command{Use: "pilot-agent"}
+	agent.AddCommand(iptables.GetCommand(), GetCommand())
+
+	if got := settings.GetString(constants.OwnerComponent); got != "" {
+		t.Errorf("default %s of istio-clean-iptables = %q, want empty", constants.OwnerComponent, got)
+	}
+	if got := viper.GetString(constants.OwnerComponent); got != constants.DefaultOwnerComponent {
+		t.Errorf("default %s of istio-iptables = %q, want %q", constants.OwnerComponent, got, constants.DefaultOwnerComponent)
+	}
+
+	if err := iptables.GetCommand().ParseFlags([]string{"--" + constants.OwnerComponent, "istio-cni"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := settings.GetString(constants.OwnerComponent); got != "" {
+		t.Errorf("%s of istio-clean-iptables = %q after setting the istio-iptables flag, want empty", constants.OwnerComponent, got)
+	}
+
+	args := []string{
+		"--" + constants.DryRun,
+		"--" + constants.OwnerComponent, "istio-iptables",
+		"--" + constants.OwnerRevision, "canary",
+		"--" + constants.IptablesVariant, constants.IptablesVariantNft,
+	}
+	if err := GetCommand().ParseFlags(args); err != nil {
+		t.Fatal(err)
+	}
+	if !settings.GetBool(constants.DryRun) {
+		t.Errorf("%s of istio-clean-iptables is not set", constants.DryRun)
+	}
+	for key, want := range map[string]string{
+		constants.OwnerComponent:  "istio-iptables",
+		constants.OwnerRevision:   "canary",
+		constants.IptablesVariant: constants.IptablesVariantNft,
+	} {
+		if got := settings.GetString(key); got != want {
+			t.Errorf("%s of istio-clean-iptables = %q, want %q", key, got, want)
+		}
+	}
+	if got := viper.GetString(constants.OwnerComponent); got != "istio-cni" {
+		t.Errorf("%s of istio-iptables = %q, want %q", constants.OwnerComponent, got, "istio-cni")
+	}
+}
+
+func TestDryRunOnlyReadsRules(t *testing.T) {
+	save := &recordingDependencies{save: iptablesSave}
+	ext := &dryRunDependencies{save: save}
+	if err := removeOwnedRules(ext, "iptables", "iptables-save", builder.Owner{Component: "istio-cni", Revision: "canary"}); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"iptables-save"}
+	if !reflect.DeepEqual(save.executed, expected) {
+		t.Errorf("Output mismatch\nExpected: %#v\nActual: %#v", expected, save.executed)
+	}
+}
@@ -37,6 +37,7 @@ type Rules struct {
 // IptablesBuilderImpl is an implementation for IptablesBuilder interface
 type IptablesBuilderImpl struct {
 	rules Rules
+	owner *Owner
 }
 
 // NewIptablesBuilders creates a new IptablesBuilder
@@ -49,6 +50,28 @@ func NewIptablesBuilder() *IptablesBuilderImpl {
 	}
 }
 
+// SetOwner tags every rule built from now on with an ownership comment for the given owner
+func (rb *IptablesBuilderImpl) SetOwner(owner Owner) {
+	rb.owner = &owner
+}
+
+// ruleParams returns the parameters of the rule, with the ownership comment inserted right after
+// the chain (and position) specification if an owner is set.
+func (rb *IptablesBuilderImpl) ruleParams(r *Rule) []string {
+	if rb.owner == nil {
+		return r.params
+	}
+	// params start with either "-A <chain>" or "-I <chain> <position>"
+	specLen := 2
+	if r.params[0] == "-I" {
+		specLen = 3
+	}
+	params := make([]string, 0, len(r.params)+4)
+	params = append(params, r.params[:specLen]...)
+	params = append(params, "-m", "comment", "--comment", rb.owner.Comment())
+	return append(params, r.params[specLen:]...)
+}
+
 func (rb *IptablesBuilderImpl) InsertRuleV4(chain string, table string, position int, params ...string) IptablesProducer {
 	rb.rules.rulesv4 = append(rb.rules.rulesv4, &Rule{
 		chain:  chain,
@@ -101,7 +124,7 @@ func (rb *IptablesBuilderImpl) buildRules(command string, rules []*Rule) [][]str
 		}
 	}
 	for _, r := range rules {
-		cmd := append([]string{command, "-t", r.table}, r.params...)
+		cmd := append([]string{command, "-t", r.table}, rb.ruleParams(r)...)
 		output = append(output, cmd)
 	}
 	return output
@@ -150,7 +173,7 @@ func (rb *IptablesBuilderImpl) buildRestore(rules []*Rule) string {
 	}
 
 	for _, r := range rules {
-		tableRulesMap[r.table] = append(tableRulesMap[r.table], strings.Join(r.params, " "))
+		tableRulesMap[r.table] = append(tableRulesMap[r.table], strings.Join(rb.ruleParams(r), " "))
 	}
 	return rb.constructIptablesRestoreContents(tableRulesMap)
 
@@ -16,6 +16,7 @@ package builder
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"istio.io/istio/tools/istio-iptables/pkg/constants"
@@ -306,3 +307,79 @@ func TestBuildV4V6MultipleRulesWithNewChain(t *testing.T) {
 		t.Errorf("Actual and expected output mismatch; but instead got Actual: %#v ; Expected: %#v", actualV6, expectedV6)
 	}
 }
+
+func TestBuildWithOwner(t *testing.T) {
+	iptables := NewIptablesBuilder()
+	iptables.SetOwner(Owner{Component: "istio-cni", Revision: "canary", Generation: RuleGeneration})
+	iptables.AppendRuleV4("chain", "table", "-f", "foo", "-b", "bar")
+	iptables.InsertRuleV4("chain", "table", 2, "-f", "foo")
+	actual := iptables.BuildV4()
+	expected := [][]string{
+		{"iptables", "-t", "table", "-N", "chain"},
+		{"iptables", "-t", "table", "-A", "chain", "-m", "comment", "--comment", "owner=istio-cni,revision=canary,generation=1",
+			"-f", "foo", "-b", "bar"},
+		{"iptables", "-t", "table", "-I", "chain", "2", "-m", "comment", "--comment", "owner=istio-cni,revision=canary,generation=1",
+			"-f", "foo"},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Actual and expected output mismatch; but instead got Actual: %#v ; Expected: %#v", actual, expected)
+	}
+}
+
+func TestParseOwnerComment(t *testing.T) {
+	owner := Owner{Component: "istio-iptables", Revision: "", Generation: RuleGeneration}
+	parsed, ok := ParseOwnerComment(owner.Comment())
+	if !ok || !reflect.DeepEqual(parsed, owner) {
+		t.Errorf("Expected to parse %#v back; got %#v (ok=%t)", owner, parsed, ok)
+	}
+	for _, comment := range []string{"", "kubernetes service portals", "owner=istio-cni", "owner=,revision=a,generation=1"} {
+		if _, ok := ParseOwnerComment(comment); ok {
+			t.Errorf("Expected comment %q not to be parsed as an owner", comment)
+		}
+	}
+}
+
+func TestOwnerOwns(t *testing.T) {
+	tagged := Owner{Component: "istio-cni", Revision: "canary", Generation: RuleGeneration}
+	cases := []struct {
+		owner Owner
+		owns  bool
+	}{
+		{Owner{Component: "istio-cni"}, true},
+		{Owner{Component: "istio-cni", Revision: "canary"}, true},
+		{Owner{Component: "istio-cni", Revision: "stable"}, false},
+		{Owner{Component: "istio-iptables", Revision: "canary"}, false},
+	}
+	for _, c := range cases {
+		if got := c.owner.Owns(tagged); got != c.owns {
+			t.Errorf("Expected %#v.Owns(%#v) to be %t", c.owner, tagged, c.owns)
+		}
+	}
+}
+
+func TestOwnerValidate(t *testing.T) {
+	valid := []Owner{
+		{Component: "istio-cni"},
+		{Component: "istio-cni", Revision: "1-8_0.canary", Generation: RuleGeneration},
+	}
+	for _, owner := range valid {
+		if err := owner.Validate(); err != nil {
+			t.Errorf("Expected %#v to be valid; got %v", owner, err)
+		}
+	}
+	invalid := []Owner{
+		{},
+		{Revision: "canary"},
+		{Component: "istio cni"},
+		{Component: "istio-cni", Revision: "a,b"},
+		{Component: "istio-cni", Revision: "a=b"},
+		{Component: "istio-cni", Revision: "canary\n"},
+		{Component: "istio-cni", Revision: `"canary"`},
+		{Component: strings.Repeat("a", 256)},
+	}
+	for _, owner := range invalid {
+		if err := owner.Validate(); err == nil {
+			t.Errorf("Expected %#v to be rejected", owner)
+		}
+	}
+}
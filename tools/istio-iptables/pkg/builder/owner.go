@@ -0,0 +1,99 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// RuleGeneration identifies the layout of the rules generated by this version of istio-iptables.
+// It must be bumped whenever the generated rule set changes in a way that matters to cleanup.
+const RuleGeneration = "1"
+
+// maxCommentLength is the longest comment accepted by the iptables comment match, excluding the
+// terminating NUL byte.
+const maxCommentLength = 255
+
+// ownerValueRegexp matches the characters allowed in the fields of an owner. Whitespace would break
+// the iptables-restore input, while ',' and '=' would make the comment impossible to parse back.
+var ownerValueRegexp = regexp.MustCompile(`^[A-Za-z0-9._-]*$`)
+
+// Owner identifies the component that programmed a rule. It is rendered into an iptables comment
+// attached to every generated rule, so that rules can later be removed selectively.
+type Owner struct {
+	Component  string
+	Revision   string
+	Generation string
+}
+
+// Validate returns an error if the owner cannot be rendered as an iptables comment and parsed back
+// by ParseOwnerComment.
+func (o Owner) Validate() error {
+	if o.Component == "" {
+		return fmt.Errorf("owner component must not be empty")
+	}
+	for _, value := range []string{o.Component, o.Revision, o.Generation} {
+		if !ownerValueRegexp.MatchString(value) {
+			return fmt.Errorf("invalid owner %q, only letters, digits, '.', '_' and '-' are allowed", value)
+		}
+	}
+	if comment := o.Comment(); len(comment) > maxCommentLength {
+		return fmt.Errorf("owner comment %q is longer than %d characters", comment, maxCommentLength)
+	}
+	return nil
+}
+
+// Comment renders the owner as an iptables comment. For a valid owner, the result contains no
+// whitespace, so it can be used verbatim both as a command argument and in iptables-restore input.
+func (o Owner) Comment() string {
+	return fmt.Sprintf("owner=%s,revision=%s,generation=%s", o.Component, o.Revision, o.Generation)
+}
+
+// Owns returns true if a rule tagged with other was programmed by this owner. An empty revision
+// matches every revision of the component; the generation is not taken into account.
+func (o Owner) Owns(other Owner) bool {
+	if o.Component != other.Component {
+		return false
+	}
+	return o.Revision == "" || o.Revision == other.Revision
+}
+
+// ParseOwnerComment parses a comment produced by Owner.Comment. The second return value is false
+// if the comment was not generated by istio-iptables.
+func ParseOwnerComment(comment string) (Owner, bool) {
+	fields := map[string]string{}
+	for _, kv := range strings.Split(comment, ",") {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 {
+			return Owner{}, false
+		}
+		fields[parts[0]] = parts[1]
+	}
+	component, ok := fields["owner"]
+	if !ok || component == "" {
+		return Owner{}, false
+	}
+	revision, ok := fields["revision"]
+	if !ok {
+		return Owner{}, false
+	}
+	generation, ok := fields["generation"]
+	if !ok {
+		return Owner{}, false
+	}
+	return Owner{Component: component, Revision: revision, Generation: generation}, true
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"istio.io/istio/tools/istio-iptables/pkg/builder"
 	"istio.io/istio/tools/istio-iptables/pkg/config"
 	"istio.io/istio/tools/istio-iptables/pkg/constants"
 	dep "istio.io/istio/tools/istio-iptables/pkg/dependencies"
@@ -50,7 +51,12 @@ var rootCmd = &cobra.Command{
 		if cfg.DryRun {
 			ext = &dep.StdoutStubDependencies{}
 		} else {
-			ext = &dep.RealDependencies{}
+			variant, err := dep.ResolveIptablesVariant(cfg.IptablesVariant)
+			if err != nil {
+				handleError(err)
+			}
+			cfg.IptablesVariant = variant
+			ext = &dep.RealDependencies{IptablesVariant: variant}
 		}
 
 		iptConfigurator := NewIptablesConfigurator(cfg, ext)
@@ -95,6 +101,18 @@ func constructConfig() *config.Config {
 		ProbeTimeout:            viper.GetDuration(constants.ProbeTimeout),
 		SkipRuleApply:           viper.GetBool(constants.SkipRuleApply),
 		RunValidation:           viper.GetBool(constants.RunValidation),
+		DropInvalid:             viper.GetBool(constants.DropInvalid),
+		DropMartianSources:      viper.GetBool(constants.DropMartianSources),
+		OwnerComponent:          viper.GetString(constants.OwnerComponent),
+		OwnerRevision:           viper.GetString(constants.OwnerRevision),
+		IptablesVariant:         viper.GetString(constants.IptablesVariant),
+	}
+
+	if cfg.OwnerComponent != "" {
+		owner := builder.Owner{Component: cfg.OwnerComponent, Revision: cfg.OwnerRevision, Generation: builder.RuleGeneration}
+		if err := owner.Validate(); err != nil {
+			handleError(err)
+		}
 	}
 
 	// TODO: Make this more configurable, maybe with an allowlist of users to be captured for output instead of a denylist.
@@ -299,6 +317,42 @@ func init() {
 		handleError(err)
 	}
 	viper.SetDefault(constants.RunValidation, false)
+
+	rootCmd.Flags().Bool(constants.DropInvalid, false, "Drop inbound packets whose conntrack state is INVALID")
+	if err := viper.BindPFlag(constants.DropInvalid, rootCmd.Flags().Lookup(constants.DropInvalid)); err != nil {
+		handleError(err)
+	}
+	viper.SetDefault(constants.DropInvalid, false)
+
+	rootCmd.Flags().Bool(constants.DropMartianSources, false,
+		"Drop inbound packets with a loopback source address that do not arrive on the loopback interface")
+	if err := viper.BindPFlag(constants.DropMartianSources, rootCmd.Flags().Lookup(constants.DropMartianSources)); err != nil {
+		handleError(err)
+	}
+	viper.SetDefault(constants.DropMartianSources, false)
+
+	rootCmd.Flags().String(constants.OwnerComponent, constants.DefaultOwnerComponent,
+		"Tag every generated rule with an ownership comment naming this component, so it can be removed selectively. "+
+			"An empty value disables tagging")
+	if err := viper.BindPFlag(constants.OwnerComponent, rootCmd.Flags().Lookup(constants.OwnerComponent)); err != nil {
+		handleError(err)
+	}
+	viper.SetDefault(constants.OwnerComponent, constants.DefaultOwnerComponent)
+
+	rootCmd.Flags().String(constants.OwnerRevision, "",
+		"Revision recorded in the ownership comment. Only used together with --"+constants.OwnerComponent)
+	if err := viper.BindPFlag(constants.OwnerRevision, rootCmd.Flags().Lookup(constants.OwnerRevision)); err != nil {
+		handleError(err)
+	}
+	viper.SetDefault(constants.OwnerRevision, "")
+
+	rootCmd.Flags().String(constants.IptablesVariant, "",
+		"The iptables variant to use, either \""+constants.IptablesVariantLegacy+"\", \""+constants.IptablesVariantNft+
+			"\" or \""+constants.IptablesVariantAuto+"\" to detect the variant already holding rules. If unset, the default iptables binaries are used")
+	if err := viper.BindPFlag(constants.IptablesVariant, rootCmd.Flags().Lookup(constants.IptablesVariant)); err != nil {
+		handleError(err)
+	}
+	viper.SetDefault(constants.IptablesVariant, "")
 }
 
 func GetCommand() *cobra.Command {
@@ -36,8 +36,16 @@ type IptablesConfigurator struct {
 }
 
 func NewIptablesConfigurator(cfg *config.Config, ext dep.Dependencies) *IptablesConfigurator {
+	iptables := builder.NewIptablesBuilder()
+	if cfg.OwnerComponent != "" {
+		iptables.SetOwner(builder.Owner{
+			Component:  cfg.OwnerComponent,
+			Revision:   cfg.OwnerRevision,
+			Generation: builder.RuleGeneration,
+		})
+	}
 	return &IptablesConfigurator{
-		iptables: builder.NewIptablesBuilder(),
+		iptables: iptables,
 		ext:      ext,
 		cfg:      cfg,
 	}
@@ -337,6 +345,37 @@ func (iptConfigurator *IptablesConfigurator) shortCircuitKubeInternalInterface()
 	}
 }
 
+func (iptConfigurator *IptablesConfigurator) handleHardeningRules() {
+	if !iptConfigurator.cfg.DropInvalid && !iptConfigurator.cfg.DropMartianSources {
+		return
+	}
+	// The rules live in their own chain so that istio-clean-iptables can remove them. The jump is
+	// appended to the mangle PREROUTING chain before any Istio jump is programmed, so offending
+	// packets are dropped before they can be redirected to Envoy.
+	iptConfigurator.iptables.AppendRuleV4(constants.PREROUTING, constants.MANGLE, "-j", constants.ISTIOHARDENING)
+	if iptConfigurator.cfg.EnableInboundIPv6 {
+		iptConfigurator.iptables.AppendRuleV6(constants.PREROUTING, constants.MANGLE, "-j", constants.ISTIOHARDENING)
+	}
+	if iptConfigurator.cfg.DropInvalid {
+		// Drop packets that conntrack could not associate with a known connection.
+		iptConfigurator.iptables.AppendRuleV4(constants.ISTIOHARDENING, constants.MANGLE,
+			"-m", "conntrack", "--ctstate", "INVALID", "-j", constants.DROP)
+		if iptConfigurator.cfg.EnableInboundIPv6 {
+			iptConfigurator.iptables.AppendRuleV6(constants.ISTIOHARDENING, constants.MANGLE,
+				"-m", "conntrack", "--ctstate", "INVALID", "-j", constants.DROP)
+		}
+	}
+	if iptConfigurator.cfg.DropMartianSources {
+		// Loopback sources are only legitimate on the loopback interface.
+		iptConfigurator.iptables.AppendRuleV4(constants.ISTIOHARDENING, constants.MANGLE,
+			"!", "-i", "lo", "-s", "127.0.0.0/8", "-j", constants.DROP)
+		if iptConfigurator.cfg.EnableInboundIPv6 {
+			iptConfigurator.iptables.AppendRuleV6(constants.ISTIOHARDENING, constants.MANGLE,
+				"!", "-i", "lo", "-s", "::1/128", "-j", constants.DROP)
+		}
+	}
+}
+
 func (iptConfigurator *IptablesConfigurator) run() {
 	defer func() {
 		// Best effort since we don't know if the commands exist
@@ -374,6 +413,8 @@ func (iptConfigurator *IptablesConfigurator) run() {
 		iptConfigurator.ext.RunOrFail(constants.IP, "-6", "addr", "add", "::6/128", "dev", "lo")
 	}
 
+	iptConfigurator.handleHardeningRules()
+
 	// Do not capture internal interface.
 	iptConfigurator.shortCircuitKubeInternalInterface()
 
@@ -631,31 +631,31 @@ func TestHandleInboundIpv4RulesWithUidGid(t *testing.T) {
 		"iptables -t nat -N ISTIO_REDIRECT",
 		"iptables -t nat -N ISTIO_IN_REDIRECT",
 		"iptables -t nat -N ISTIO_OUTPUT",
-		"iptables -t nat -A ISTIO_INBOUND -p tcp --dport 15008 -j RETURN",
-		"iptables -t nat -A ISTIO_REDIRECT -p tcp --dport 53 -j REDIRECT --to-ports 15053",
-		"iptables -t nat -A ISTIO_REDIRECT -p tcp -j REDIRECT --to-ports 15001",
-		"iptables -t nat -A ISTIO_IN_REDIRECT -p tcp -j REDIRECT --to-ports 15006",
-		"iptables -t nat -A OUTPUT -p tcp -j ISTIO_OUTPUT",
-		"iptables -t nat -A ISTIO_OUTPUT -o lo -s 127.0.0.6/32 -j RETURN",
-		"iptables -t nat -A ISTIO_OUTPUT -o lo ! -d 127.0.0.1/32 -m owner --uid-owner 3 -j ISTIO_IN_REDIRECT",
-		"iptables -t nat -A ISTIO_OUTPUT -o lo -m owner ! --uid-owner 3 -j RETURN",
-		"iptables -t nat -A ISTIO_OUTPUT -m owner --uid-owner 3 -j RETURN",
-		"iptables -t nat -A ISTIO_OUTPUT -o lo ! -d 127.0.0.1/32 -m owner --uid-owner 4 -j ISTIO_IN_REDIRECT",
-		"iptables -t nat -A ISTIO_OUTPUT -o lo -m owner ! --uid-owner 4 -j RETURN",
-		"iptables -t nat -A ISTIO_OUTPUT -m owner --uid-owner 4 -j RETURN",
-		"iptables -t nat -A ISTIO_OUTPUT -o lo ! -d 127.0.0.1/32 -m owner --gid-owner 1 -j ISTIO_IN_REDIRECT",
-		"iptables -t nat -A ISTIO_OUTPUT -o lo -m owner ! --gid-owner 1 -j RETURN",
-		"iptables -t nat -A ISTIO_OUTPUT -m owner --gid-owner 1 -j RETURN",
-		"iptables -t nat -A ISTIO_OUTPUT -o lo ! -d 127.0.0.1/32 -m owner --gid-owner 2 -j ISTIO_IN_REDIRECT",
-		"iptables -t nat -A ISTIO_OUTPUT -o lo -m owner ! --gid-owner 2 -j RETURN",
-		"iptables -t nat -A ISTIO_OUTPUT -m owner --gid-owner 2 -j RETURN",
-		"iptables -t nat -A ISTIO_OUTPUT -d 127.0.0.1/32 -j RETURN",
-		"iptables -t nat -A OUTPUT -p udp --dport 53 -m owner --uid-owner 3 -j RETURN",
-		"iptables -t nat -A OUTPUT -p udp --dport 53 -m owner --uid-owner 4 -j RETURN",
-		"iptables -t nat -A OUTPUT -p udp --dport 53 -m owner --gid-owner 1 -j RETURN",
-		"iptables -t nat -A OUTPUT -p udp --dport 53 -m owner --gid-owner 2 -j RETURN",
-		"iptables -t nat -A OUTPUT -p udp --dport 53 -j DNAT --to-destination 127.0.0.1:15053",
-		"iptables -t nat -A POSTROUTING -p udp --dport 15053 -j SNAT --to-source 127.0.0.1",
+		"iptables -t nat -A ISTIO_INBOUND -m comment --comment owner=istio-iptables,revision=,generation=1 -p tcp --dport 15008 -j RETURN",
+		"iptables -t nat -A ISTIO_REDIRECT -m comment --comment owner=istio-iptables,revision=,generation=1 -p tcp --dport 53 -j REDIRECT --to-ports 15053",
+		"iptables -t nat -A ISTIO_REDIRECT -m comment --comment owner=istio-iptables,revision=,generation=1 -p tcp -j REDIRECT --to-ports 15001",
+		"iptables -t nat -A ISTIO_IN_REDIRECT -m comment --comment owner=istio-iptables,revision=,generation=1 -p tcp -j REDIRECT --to-ports 15006",
+		"iptables -t nat -A OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -p tcp -j ISTIO_OUTPUT",
+		"iptables -t nat -A ISTIO_OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -o lo -s 127.0.0.6/32 -j RETURN",
+		"iptables -t nat -A ISTIO_OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -o lo ! -d 127.0.0.1/32 -m owner --uid-owner 3 -j ISTIO_IN_REDIRECT",
+		"iptables -t nat -A ISTIO_OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -o lo -m owner ! --uid-owner 3 -j RETURN",
+		"iptables -t nat -A ISTIO_OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -m owner --uid-owner 3 -j RETURN",
+		"iptables -t nat -A ISTIO_OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -o lo ! -d 127.0.0.1/32 -m owner --uid-owner 4 -j ISTIO_IN_REDIRECT",
+		"iptables -t nat -A ISTIO_OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -o lo -m owner ! --uid-owner 4 -j RETURN",
+		"iptables -t nat -A ISTIO_OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -m owner --uid-owner 4 -j RETURN",
+		"iptables -t nat -A ISTIO_OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -o lo ! -d 127.0.0.1/32 -m owner --gid-owner 1 -j ISTIO_IN_REDIRECT",
+		"iptables -t nat -A ISTIO_OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -o lo -m owner ! --gid-owner 1 -j RETURN",
+		"iptables -t nat -A ISTIO_OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -m owner --gid-owner 1 -j RETURN",
+		"iptables -t nat -A ISTIO_OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -o lo ! -d 127.0.0.1/32 -m owner --gid-owner 2 -j ISTIO_IN_REDIRECT",
+		"iptables -t nat -A ISTIO_OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -o lo -m owner ! --gid-owner 2 -j RETURN",
+		"iptables -t nat -A ISTIO_OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -m owner --gid-owner 2 -j RETURN",
+		"iptables -t nat -A ISTIO_OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -d 127.0.0.1/32 -j RETURN",
+		"iptables -t nat -A OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -p udp --dport 53 -m owner --uid-owner 3 -j RETURN",
+		"iptables -t nat -A OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -p udp --dport 53 -m owner --uid-owner 4 -j RETURN",
+		"iptables -t nat -A OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -p udp --dport 53 -m owner --gid-owner 1 -j RETURN",
+		"iptables -t nat -A OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -p udp --dport 53 -m owner --gid-owner 2 -j RETURN",
+		"iptables -t nat -A OUTPUT -m comment --comment owner=istio-iptables,revision=,generation=1 -p udp --dport 53 -j DNAT --to-destination 127.0.0.1:15053",
+		"iptables -t nat -A POSTROUTING -m comment --comment owner=istio-iptables,revision=,generation=1 -p udp --dport 15053 -j SNAT --to-source 127.0.0.1",
 	}
 
 	if !reflect.DeepEqual(actual, expected) {
@@ -745,3 +745,67 @@ func TestRulesWithLoopbackIpInOutboundIpRanges(t *testing.T) {
 		t.Errorf("Output mismatch. Expected: \n%#v ; Actual: \n%#v", expected, actual)
 	}
 }
+
+func TestHandleHardeningRules(t *testing.T) {
+	cfg := constructTestConfig()
+	cfg.DropInvalid = true
+	cfg.DropMartianSources = true
+	cfg.EnableInboundIPv6 = true
+
+	iptConfigurator := NewIptablesConfigurator(cfg, &dep.StdoutStubDependencies{})
+	iptConfigurator.handleHardeningRules()
+
+	ip4Rules := FormatIptablesCommands(iptConfigurator.iptables.BuildV4())
+	expectedIpv4Rules := []string{
+		"iptables -t mangle -N ISTIO_HARDENING",
+		"iptables -t mangle -A PREROUTING -j ISTIO_HARDENING",
+		"iptables -t mangle -A ISTIO_HARDENING -m conntrack --ctstate INVALID -j DROP",
+		"iptables -t mangle -A ISTIO_HARDENING ! -i lo -s 127.0.0.0/8 -j DROP",
+	}
+	if !reflect.DeepEqual(ip4Rules, expectedIpv4Rules) {
+		t.Errorf("Output mismatch\nExpected: %#v\nActual: %#v", expectedIpv4Rules, ip4Rules)
+	}
+	ip6Rules := FormatIptablesCommands(iptConfigurator.iptables.BuildV6())
+	expectedIpv6Rules := []string{
+		"ip6tables -t mangle -N ISTIO_HARDENING",
+		"ip6tables -t mangle -A PREROUTING -j ISTIO_HARDENING",
+		"ip6tables -t mangle -A ISTIO_HARDENING -m conntrack --ctstate INVALID -j DROP",
+		"ip6tables -t mangle -A ISTIO_HARDENING ! -i lo -s ::1/128 -j DROP",
+	}
+	if !reflect.DeepEqual(ip6Rules, expectedIpv6Rules) {
+		t.Errorf("Output mismatch\nExpected: %#v\nActual: %#v", expectedIpv6Rules, ip6Rules)
+	}
+}
+
+func TestHandleHardeningRulesDisabled(t *testing.T) {
+	cfg := constructTestConfig()
+	cfg.EnableInboundIPv6 = true
+
+	iptConfigurator := NewIptablesConfigurator(cfg, &dep.StdoutStubDependencies{})
+	iptConfigurator.handleHardeningRules()
+
+	ip4Rules := FormatIptablesCommands(iptConfigurator.iptables.BuildV4())
+	ip6Rules := FormatIptablesCommands(iptConfigurator.iptables.BuildV6())
+	if !reflect.DeepEqual([]string{}, ip4Rules) || !reflect.DeepEqual([]string{}, ip6Rules) {
+		t.Errorf("Expected no hardening rules by default; instead got %#v and %#v", ip4Rules, ip6Rules)
+	}
+}
+
+func TestRulesWithOwner(t *testing.T) {
+	cfg := constructTestConfig()
+	cfg.OwnerComponent = "istio-cni"
+	cfg.OwnerRevision = "canary"
+	cfg.OutboundPortsInclude = "32000"
+
+	iptConfigurator := NewIptablesConfigurator(cfg, &dep.StdoutStubDependencies{})
+	iptConfigurator.handleOutboundPortsInclude()
+
+	actual := FormatIptablesCommands(iptConfigurator.iptables.BuildV4())
+	expected := []string{
+		"iptables -t nat -N ISTIO_OUTPUT",
+		"iptables -t nat -A ISTIO_OUTPUT -m comment --comment owner=istio-cni,revision=canary,generation=1 -p tcp --dport 32000 -j ISTIO_REDIRECT",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Output mismatch\nExpected: %#v\nActual: %#v", expected, actual)
+	}
+}
@@ -47,6 +47,11 @@ type Config struct {
 	SkipRuleApply           bool          `json:"SKIP_RULE_APPLY"`
 	RunValidation           bool          `json:"RUN_VALIDATION"`
 	EnableInboundIPv6       bool          `json:"ENABLE_INBOUND_IPV6"`
+	DropInvalid             bool          `json:"DROP_INVALID"`
+	DropMartianSources      bool          `json:"DROP_MARTIAN_SOURCES"`
+	OwnerComponent          string        `json:"OWNER_COMPONENT"`
+	OwnerRevision           string        `json:"OWNER_REVISION"`
+	IptablesVariant         string        `json:"IPTABLES_VARIANT"`
 }
 
 func (c *Config) String() string {
@@ -76,5 +81,10 @@ func (c *Config) Print() {
 	fmt.Printf("OUTBOUND_PORTS_EXCLUDE=%s\n", c.OutboundPortsExclude)
 	fmt.Printf("KUBEVIRT_INTERFACES=%s\n", c.KubevirtInterfaces)
 	fmt.Printf("ENABLE_INBOUND_IPV6=%t\n", c.EnableInboundIPv6)
+	fmt.Printf("DROP_INVALID=%t\n", c.DropInvalid)
+	fmt.Printf("DROP_MARTIAN_SOURCES=%t\n", c.DropMartianSources)
+	fmt.Printf("OWNER_COMPONENT=%s\n", c.OwnerComponent)
+	fmt.Printf("OWNER_REVISION=%s\n", c.OwnerRevision)
+	fmt.Printf("IPTABLES_VARIANT=%s\n", c.IptablesVariant)
 	fmt.Println("")
 }
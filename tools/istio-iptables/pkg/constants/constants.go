@@ -51,6 +51,7 @@ const (
 	REJECT   = "REJECT"
 	REDIRECT = "REDIRECT"
 	MARK     = "MARK"
+	DROP     = "DROP"
 )
 
 // iptables chains
@@ -61,6 +62,7 @@ const (
 	ISTIOTPROXY     = "ISTIO_TPROXY"
 	ISTIOREDIRECT   = "ISTIO_REDIRECT"
 	ISTIOINREDIRECT = "ISTIO_IN_REDIRECT"
+	ISTIOHARDENING  = "ISTIO_HARDENING"
 )
 
 // Constants used in cobra/viper CLI
@@ -87,10 +89,16 @@ const (
 	RunValidation             = "run-validation"
 	IptablesProbePort         = "iptables-probe-port"
 	ProbeTimeout              = "probe-timeout"
+	DropInvalid               = "drop-invalid"
+	DropMartianSources        = "drop-martian-sources"
+	OwnerComponent            = "owner-component"
+	OwnerRevision             = "owner-revision"
+	IptablesVariant           = "iptables-variant"
 )
 
 const (
-	DefaultProxyUID = "1337"
+	DefaultProxyUID       = "1337"
+	DefaultOwnerComponent = "istio-iptables"
 )
 
 // Constants used in environment variables
@@ -110,6 +118,14 @@ const (
 	IP               = "ip"
 )
 
+// iptables variants, i.e. the kernel backend targeted by the iptables binaries
+const (
+	IptablesVariantLegacy = "legacy"
+	IptablesVariantNft    = "nft"
+	// IptablesVariantAuto detects the variant from the rules already programmed in the network namespace
+	IptablesVariantAuto = "auto"
+)
+
 // Constants for syscall
 const (
 	// sys/socket.h
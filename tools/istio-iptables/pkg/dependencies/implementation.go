@@ -23,9 +23,30 @@ import (
 
 // RealDependencies implementation of interface Dependencies, which is used in production
 type RealDependencies struct {
+	// IptablesVariant selects the iptables binaries to execute, see constants.IptablesVariantLegacy and
+	// constants.IptablesVariantNft. The default binaries are used if it is empty, or if the binaries of
+	// the variant are not installed.
+	IptablesVariant string
+}
+
+// lookPath is replaced in tests, so that they do not depend on the binaries installed on the host
+var lookPath = exec.LookPath
+
+// binary returns the binary to execute for cmd. Hosts running iptables older than 1.8 do not ship the
+// binaries of each variant, the default binaries are then used instead.
+func (r *RealDependencies) binary(cmd string) string {
+	variantCmd := iptablesBinary(cmd, r.IptablesVariant)
+	if variantCmd == cmd {
+		return cmd
+	}
+	if _, err := lookPath(variantCmd); err != nil {
+		return cmd
+	}
+	return variantCmd
 }
 
 func (r *RealDependencies) execute(cmd string, redirectStdout bool, args ...string) error {
+	cmd = r.binary(cmd)
 	fmt.Printf("%s %s\n", cmd, strings.Join(args, " "))
 	externalCommand := exec.Command(cmd, args...)
 	externalCommand.Stdout = os.Stdout
@@ -54,3 +75,12 @@ func (r *RealDependencies) Run(cmd string, args ...string) error {
 func (r *RealDependencies) RunQuietlyAndIgnore(cmd string, args ...string) {
 	_ = r.execute(cmd, true, args...)
 }
+
+// RunWithOutput runs a command and returns its standard output
+func (r *RealDependencies) RunWithOutput(cmd string, args ...string) ([]byte, error) {
+	cmd = r.binary(cmd)
+	fmt.Printf("%s %s\n", cmd, strings.Join(args, " "))
+	externalCommand := exec.Command(cmd, args...)
+	externalCommand.Stderr = os.Stderr
+	return externalCommand.Output()
+}
@@ -22,4 +22,6 @@ type Dependencies interface {
 	Run(cmd string, args ...string) error
 	// RunQuietlyAndIgnore runs a command quietly and ignores errors
 	RunQuietlyAndIgnore(cmd string, args ...string)
+	// RunWithOutput runs a command and returns its standard output
+	RunWithOutput(cmd string, args ...string) ([]byte, error)
 }
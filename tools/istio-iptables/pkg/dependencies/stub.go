@@ -38,3 +38,9 @@ func (s *StdoutStubDependencies) Run(cmd string, args ...string) error {
 func (s *StdoutStubDependencies) RunQuietlyAndIgnore(cmd string, args ...string) {
 	fmt.Printf("%s %s\n", cmd, strings.Join(args, " "))
 }
+
+// RunWithOutput runs a command and returns no output
+func (s *StdoutStubDependencies) RunWithOutput(cmd string, args ...string) ([]byte, error) {
+	fmt.Printf("%s %s\n", cmd, strings.Join(args, " "))
+	return nil, nil
+}
@@ -0,0 +1,106 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencies
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"istio.io/istio/tools/istio-iptables/pkg/constants"
+)
+
+// iptablesBinary returns the binary implementing cmd for the given iptables variant. Commands that
+// are not part of iptables, as well as any command when no variant is set, are returned unchanged.
+func iptablesBinary(cmd string, variant string) string {
+	if variant == "" {
+		return cmd
+	}
+	switch cmd {
+	case constants.IPTABLES, constants.IPTABLESRESTORE, constants.IPTABLESSAVE:
+		return strings.Replace(cmd, constants.IPTABLES, constants.IPTABLES+"-"+variant, 1)
+	case constants.IP6TABLES, constants.IP6TABLESRESTORE, constants.IP6TABLESSAVE:
+		return strings.Replace(cmd, constants.IP6TABLES, constants.IP6TABLES+"-"+variant, 1)
+	default:
+		return cmd
+	}
+}
+
+// countRules returns the number of rules in the output of iptables-save
+func countRules(save []byte) int {
+	rules := 0
+	scanner := bufio.NewScanner(bytes.NewReader(save))
+	for scanner.Scan() {
+		if strings.HasPrefix(scanner.Text(), "-A ") {
+			rules++
+		}
+	}
+	return rules
+}
+
+// selectIptablesVariant picks the variant which already holds the most rules, as that is the one
+// used by the other components (e.g. kube-proxy or the CNI) sharing the network namespace.
+func selectIptablesVariant(legacySave []byte, nftSave []byte) string {
+	legacy, nft := countRules(legacySave), countRules(nftSave)
+	switch {
+	case nft > legacy:
+		return constants.IptablesVariantNft
+	case legacy > nft:
+		return constants.IptablesVariantLegacy
+	default:
+		return ""
+	}
+}
+
+// DetectIptablesVariant inspects the rules programmed in the current network namespace through both
+// the legacy and nft variants of iptables, and returns the one in use. An empty string is returned
+// if it cannot be determined, in which case the default iptables binaries should be used.
+// install-cni calls it once when writing the CNI config, rather than the CNI plugin for every pod.
+func DetectIptablesVariant() string {
+	// Errors are expected when a variant is not installed, it then simply holds no rules.
+	legacySave, _ := exec.Command(iptablesBinary(constants.IPTABLESSAVE, constants.IptablesVariantLegacy)).Output()
+	nftSave, _ := exec.Command(iptablesBinary(constants.IPTABLESSAVE, constants.IptablesVariantNft)).Output()
+	return selectIptablesVariant(legacySave, nftSave)
+}
+
+// ValidateIptablesVariant returns an error if variant is neither empty, a known iptables variant
+// nor constants.IptablesVariantAuto.
+func ValidateIptablesVariant(variant string) error {
+	switch variant {
+	case "", constants.IptablesVariantLegacy, constants.IptablesVariantNft, constants.IptablesVariantAuto:
+		return nil
+	default:
+		return fmt.Errorf("invalid iptables variant %q, must be %q, %q or %q",
+			variant, constants.IptablesVariantLegacy, constants.IptablesVariantNft, constants.IptablesVariantAuto)
+	}
+}
+
+// detectIptablesVariant is replaced in tests, so that they do not depend on the rules of the host
+var detectIptablesVariant = DetectIptablesVariant
+
+// ResolveIptablesVariant validates the requested iptables variant. The variant is only detected from
+// the existing rules when constants.IptablesVariantAuto is requested, as that runs both variants of
+// iptables-save. An empty variant selects the default iptables binaries.
+func ResolveIptablesVariant(variant string) (string, error) {
+	if err := ValidateIptablesVariant(variant); err != nil {
+		return "", err
+	}
+	if variant == constants.IptablesVariantAuto {
+		return detectIptablesVariant(), nil
+	}
+	return variant, nil
+}
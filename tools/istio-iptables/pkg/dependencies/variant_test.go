@@ -0,0 +1,141 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencies
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"istio.io/istio/tools/istio-iptables/pkg/constants"
+)
+
+const (
+	emptySave = `# Generated by iptables-save v1.8.4 on Thu Jan  1 00:00:00 1970
+*nat
+:PREROUTING ACCEPT [0:0]
+:OUTPUT ACCEPT [0:0]
+COMMIT
+`
+	kubeProxySave = `# Generated by iptables-save v1.8.4 on Thu Jan  1 00:00:00 1970
+*nat
+:PREROUTING ACCEPT [0:0]
+:KUBE-SERVICES - [0:0]
+-A PREROUTING -m comment --comment "kubernetes service portals" -j KUBE-SERVICES
+-A OUTPUT -m comment --comment "kubernetes service portals" -j KUBE-SERVICES
+COMMIT
+`
+)
+
+func TestIptablesBinary(t *testing.T) {
+	cases := []struct {
+		cmd      string
+		variant  string
+		expected string
+	}{
+		{constants.IPTABLES, "", constants.IPTABLES},
+		{constants.IPTABLES, constants.IptablesVariantLegacy, "iptables-legacy"},
+		{constants.IPTABLESRESTORE, constants.IptablesVariantNft, "iptables-nft-restore"},
+		{constants.IPTABLESSAVE, constants.IptablesVariantLegacy, "iptables-legacy-save"},
+		{constants.IP6TABLES, constants.IptablesVariantNft, "ip6tables-nft"},
+		{constants.IP6TABLESRESTORE, constants.IptablesVariantLegacy, "ip6tables-legacy-restore"},
+		{constants.IP6TABLESSAVE, constants.IptablesVariantNft, "ip6tables-nft-save"},
+		{constants.IP, constants.IptablesVariantNft, constants.IP},
+	}
+	for _, c := range cases {
+		if actual := iptablesBinary(c.cmd, c.variant); actual != c.expected {
+			t.Errorf("iptablesBinary(%q, %q): expected %q, got %q", c.cmd, c.variant, c.expected, actual)
+		}
+	}
+}
+
+func TestRealDependenciesBinary(t *testing.T) {
+	defer func(look func(string) (string, error)) { lookPath = look }(lookPath)
+	// A host running iptables 1.6, which only ships the default binaries
+	lookPath = func(file string) (string, error) {
+		if strings.Contains(file, "-legacy") || strings.Contains(file, "-nft") {
+			return "", &exec.Error{Name: file, Err: exec.ErrNotFound}
+		}
+		return "/sbin/" + file, nil
+	}
+	r := &RealDependencies{IptablesVariant: constants.IptablesVariantLegacy}
+	for _, cmd := range []string{constants.IPTABLES, constants.IPTABLESSAVE, constants.IP6TABLESRESTORE} {
+		if actual := r.binary(cmd); actual != cmd {
+			t.Errorf("Expected %q to fall back to the default binary; got %q", cmd, actual)
+		}
+	}
+
+	lookPath = func(file string) (string, error) { return "/sbin/" + file, nil }
+	if actual := r.binary(constants.IPTABLESSAVE); actual != "iptables-legacy-save" {
+		t.Errorf("Expected the installed variant binary iptables-legacy-save; got %q", actual)
+	}
+}
+
+func TestSelectIptablesVariant(t *testing.T) {
+	cases := []struct {
+		name     string
+		legacy   string
+		nft      string
+		expected string
+	}{
+		{"no rules", emptySave, emptySave, ""},
+		{"nothing installed", "", "", ""},
+		{"legacy in use", kubeProxySave, emptySave, constants.IptablesVariantLegacy},
+		{"nft in use", emptySave, kubeProxySave, constants.IptablesVariantNft},
+		{"nft only installed", "", kubeProxySave, constants.IptablesVariantNft},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := selectIptablesVariant([]byte(c.legacy), []byte(c.nft)); actual != c.expected {
+				t.Errorf("Expected variant %q, got %q", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestResolveIptablesVariant(t *testing.T) {
+	defer func(detect func() string) { detectIptablesVariant = detect }(detectIptablesVariant)
+	detections := 0
+	detectIptablesVariant = func() string {
+		detections++
+		return constants.IptablesVariantNft
+	}
+
+	for _, variant := range []string{"", constants.IptablesVariantLegacy, constants.IptablesVariantNft} {
+		if actual, err := ResolveIptablesVariant(variant); err != nil || actual != variant {
+			t.Errorf("Expected %q to resolve to itself; got %q, %v", variant, actual, err)
+		}
+	}
+	if detections != 0 {
+		t.Errorf("Expected the variant to be detected only when requested; got %d detections", detections)
+	}
+	if actual, err := ResolveIptablesVariant(constants.IptablesVariantAuto); err != nil || actual != constants.IptablesVariantNft {
+		t.Errorf("Expected %q to resolve to the detected variant; got %q, %v", constants.IptablesVariantAuto, actual, err)
+	}
+	if _, err := ResolveIptablesVariant("iptables"); err == nil {
+		t.Errorf("Expected an error for an unknown variant")
+	}
+}
+
+func TestValidateIptablesVariant(t *testing.T) {
+	for _, variant := range []string{"", constants.IptablesVariantLegacy, constants.IptablesVariantNft, constants.IptablesVariantAuto} {
+		if err := ValidateIptablesVariant(variant); err != nil {
+			t.Errorf("Expected %q to be valid; got %v", variant, err)
+		}
+	}
+	if err := ValidateIptablesVariant("nftables"); err == nil {
+		t.Errorf("Expected an error for an unknown variant")
+	}
+}